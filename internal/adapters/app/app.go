@@ -26,5 +26,8 @@ func (a *App) Start() {
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
+	for _, w := range a.ServiceProvider.Workers() {
+		go w.Start(ctx)
+	}
 	a.ServiceProvider.BotRunner().Start(ctx)
 }
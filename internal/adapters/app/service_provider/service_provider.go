@@ -7,11 +7,19 @@ import (
 	"LoudQuestionBot/internal/adapters/repository/redisstate"
 	"LoudQuestionBot/internal/domain/service/access"
 	"LoudQuestionBot/internal/domain/service/admin"
+	"LoudQuestionBot/internal/domain/service/broadcast"
+	"LoudQuestionBot/internal/domain/service/daily"
 	"LoudQuestionBot/internal/domain/service/form"
 	"LoudQuestionBot/internal/domain/service/game"
+	"LoudQuestionBot/internal/domain/service/group"
+	"LoudQuestionBot/internal/domain/service/notify"
+	"LoudQuestionBot/internal/domain/service/pack"
+	"LoudQuestionBot/internal/domain/service/session"
+	"LoudQuestionBot/internal/domain/service/subscription"
 	"LoudQuestionBot/internal/domain/service/team"
-	"LoudQuestionBot/internal/domain/service/user"
 	telegramsvc "LoudQuestionBot/internal/domain/service/telegram"
+	"LoudQuestionBot/internal/domain/service/trash"
+	"LoudQuestionBot/internal/domain/service/user"
 	"context"
 	"fmt"
 	"log"
@@ -26,14 +34,23 @@ type ServiceProvider struct {
 	pgPool      *pgxpool.Pool
 	redisClient *redis.Client
 
-	accessService *access.Service
-	adminService  *admin.Service
-	gameService   *game.Service
-	formService   *form.Service
-	teamService   *team.Service
-	userService   *user.Service
+	accessService       *access.Service
+	adminService        *admin.Service
+	broadcastService    *broadcast.Service
+	dailyService        *daily.Service
+	gameService         *game.Service
+	formService         *form.Service
+	groupService        *group.Service
+	sessionService      *session.Service
+	subscriptionService *subscription.Service
+	notifyService       *notify.Service
+	packService         *pack.Service
+	teamService         *team.Service
+	trashService        *trash.Service
+	userService         *user.Service
 
 	botRunner telegramsvc.Runner
+	workers   []telegramsvc.Runner
 }
 
 func New() (*ServiceProvider, error) {
@@ -48,6 +65,10 @@ func (sp *ServiceProvider) BotRunner() telegramsvc.Runner {
 	return sp.botRunner
 }
 
+func (sp *ServiceProvider) Workers() []telegramsvc.Runner {
+	return sp.workers
+}
+
 func (sp *ServiceProvider) init() error {
 	cfg, err := config.Load()
 	if err != nil {
@@ -80,6 +101,10 @@ func (sp *ServiceProvider) init() error {
 	if err := questionRepo.Migrate(ctx); err != nil {
 		return fmt.Errorf("migrate: %w", err)
 	}
+	packRepo := postgres.NewPackRepo(sp.pgPool)
+	if err := packRepo.Migrate(ctx); err != nil {
+		return fmt.Errorf("migrate packs: %w", err)
+	}
 	teamRepo := postgres.NewTeamRepo(sp.pgPool)
 	if err := teamRepo.Migrate(ctx); err != nil {
 		return fmt.Errorf("migrate teams: %w", err)
@@ -88,20 +113,83 @@ func (sp *ServiceProvider) init() error {
 	if err := userRepo.Migrate(ctx); err != nil {
 		return fmt.Errorf("migrate users: %w", err)
 	}
+	playSettingsRepo := postgres.NewPlaySettingsRepo(sp.pgPool)
+	if err := playSettingsRepo.Migrate(ctx); err != nil {
+		return fmt.Errorf("migrate play settings: %w", err)
+	}
+	scoreRepo := postgres.NewScoreRepo(sp.pgPool)
+	if err := scoreRepo.Migrate(ctx); err != nil {
+		return fmt.Errorf("migrate scores: %w", err)
+	}
+	sessionRepo := postgres.NewGameSessionRepo(sp.pgPool)
+	if err := sessionRepo.Migrate(ctx); err != nil {
+		return fmt.Errorf("migrate game sessions: %w", err)
+	}
+	reportRepo := postgres.NewReportRepo(sp.pgPool)
+	if err := reportRepo.Migrate(ctx); err != nil {
+		return fmt.Errorf("migrate reports: %w", err)
+	}
+	auditRepo := postgres.NewAuditRepo(sp.pgPool)
+	if err := auditRepo.Migrate(ctx); err != nil {
+		return fmt.Errorf("migrate audit log: %w", err)
+	}
+	ratingRepo := postgres.NewRatingRepo(sp.pgPool)
+	if err := ratingRepo.Migrate(ctx); err != nil {
+		return fmt.Errorf("migrate ratings: %w", err)
+	}
+	dailyRepo := postgres.NewDailyQuestionRepo(sp.pgPool)
+	if err := dailyRepo.Migrate(ctx); err != nil {
+		return fmt.Errorf("migrate daily questions: %w", err)
+	}
+	broadcastRepo := postgres.NewBroadcastRepo(sp.pgPool)
+	if err := broadcastRepo.Migrate(ctx); err != nil {
+		return fmt.Errorf("migrate broadcasts: %w", err)
+	}
+	subscriptionRepo := postgres.NewSubscriptionRepo(sp.pgPool)
+	if err := subscriptionRepo.Migrate(ctx); err != nil {
+		return fmt.Errorf("migrate subscriptions: %w", err)
+	}
+	roleRepo := postgres.NewRoleRepo(sp.pgPool)
+	if err := roleRepo.Migrate(ctx); err != nil {
+		return fmt.Errorf("migrate roles: %w", err)
+	}
 	formRepo := redisstate.NewFormStateRepo(sp.redisClient)
+	groupRepo := redisstate.NewGroupRepo(sp.redisClient)
 
-	sp.accessService = access.New(cfg.AdminIDs)
-	sp.adminService = admin.New(questionRepo)
-	sp.gameService = game.New(questionRepo)
+	sp.accessService = access.New(roleRepo, cfg.OwnerIDs)
+	if err := sp.accessService.Load(ctx); err != nil {
+		return fmt.Errorf("load roles: %w", err)
+	}
+	if err := sp.accessService.ImportLegacySuperAdmins(ctx, cfg.LegacySuperAdminIDs); err != nil {
+		return fmt.Errorf("import SUPER_ADMIN_IDS: %w", err)
+	}
+	sp.notifyService = notify.New()
+	sp.subscriptionService = subscription.New(subscriptionRepo, questionRepo, playSettingsRepo, teamRepo, userRepo, sp.notifyService)
+	sp.adminService = admin.New(questionRepo, reportRepo, auditRepo, sp.subscriptionService)
+	sp.broadcastService = broadcast.New(broadcastRepo, sp.notifyService)
+	sp.dailyService = daily.New(dailyRepo, userRepo, sp.notifyService, cfg.DailyQuestionAt, cfg.DailyQuestionLocation)
+	sp.gameService = game.New(questionRepo, playSettingsRepo, packRepo, scoreRepo, reportRepo, ratingRepo)
 	sp.formService = form.New(formRepo)
+	sp.groupService = group.New(groupRepo)
+	sp.packService = pack.New(packRepo)
+	sp.sessionService = session.New(sessionRepo)
 	sp.teamService = team.New(teamRepo)
+	sp.trashService = trash.New(questionRepo, sp.subscriptionService, cfg.TrashRetention)
 	sp.userService = user.New(userRepo)
 
-	botRunner, err := tgcontroller.New(cfg.BotToken, cfg.LogChatID, sp.accessService, sp.gameService, sp.adminService, sp.formService, sp.teamService, sp.userService)
+	botRunner, err := tgcontroller.New(cfg.BotToken, cfg.LogChatID, sp.accessService, sp.gameService, sp.adminService, sp.broadcastService, sp.dailyService, sp.formService, sp.groupService, sp.packService, sp.sessionService, sp.subscriptionService, sp.teamService, sp.trashService, sp.userService)
 	if err != nil {
 		return fmt.Errorf("create telegram controller: %w", err)
 	}
 	sp.botRunner = botRunner
+	sp.workers = []telegramsvc.Runner{
+		sp.notifyService.Worker(botRunner.Sender()),
+		sp.subscriptionService,
+		sp.dailyService,
+		sp.broadcastService,
+		sp.trashService,
+		sp.accessService,
+	}
 
 	log.Println("service provider initialized")
 	return nil
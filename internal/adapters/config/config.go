@@ -5,6 +5,8 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
+	_ "time/tzdata"
 )
 
 type Config struct {
@@ -14,7 +16,14 @@ type Config struct {
 	RedisPassword string
 	RedisDB       int
 	LogChatID     int64
-	AdminIDs      map[int64]struct{}
+	OwnerIDs      map[int64]struct{}
+
+	LegacySuperAdminIDs map[int64]struct{}
+
+	DailyQuestionAt       time.Duration
+	DailyQuestionLocation *time.Location
+
+	TrashRetention time.Duration
 }
 
 func Load() (Config, error) {
@@ -23,7 +32,9 @@ func Load() (Config, error) {
 		PostgresDSN:   strings.TrimSpace(os.Getenv("POSTGRES_DSN")),
 		RedisAddr:     valueOrDefault("REDIS_ADDR", "redis:6379"),
 		RedisPassword: strings.TrimSpace(os.Getenv("REDIS_PASSWORD")),
-		AdminIDs:      parseAdminIDs(os.Getenv("ADMIN_IDS")),
+		OwnerIDs:      parseAdminIDs(os.Getenv("ADMIN_IDS")),
+
+		LegacySuperAdminIDs: parseAdminIDs(os.Getenv("SUPER_ADMIN_IDS")),
 	}
 
 	redisDBRaw := strings.TrimSpace(os.Getenv("REDIS_DB"))
@@ -47,6 +58,23 @@ func Load() (Config, error) {
 		cfg.LogChatID = v
 	}
 
+	dailyAt, err := parseTimeOfDay(valueOrDefault("DAILY_QUESTION_TIME", "10:00"))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid DAILY_QUESTION_TIME: %w", err)
+	}
+	cfg.DailyQuestionAt = dailyAt
+	loc, err := time.LoadLocation(valueOrDefault("DAILY_QUESTION_TZ", "Europe/Moscow"))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid DAILY_QUESTION_TZ: %w", err)
+	}
+	cfg.DailyQuestionLocation = loc
+
+	retentionDays, err := strconv.Atoi(valueOrDefault("TRASH_RETENTION_DAYS", "30"))
+	if err != nil || retentionDays < 0 {
+		return Config{}, fmt.Errorf("invalid TRASH_RETENTION_DAYS: %q", os.Getenv("TRASH_RETENTION_DAYS"))
+	}
+	cfg.TrashRetention = time.Duration(retentionDays) * 24 * time.Hour
+
 	if cfg.BotToken == "" {
 		return Config{}, fmt.Errorf("BOT_TOKEN is required")
 	}
@@ -81,3 +109,11 @@ func parseAdminIDs(raw string) map[int64]struct{} {
 	}
 	return res
 }
+
+func parseTimeOfDay(raw string) (time.Duration, error) {
+	t, err := time.Parse("15:04", raw)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
@@ -0,0 +1,254 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/adapters/controller/telegram/i18n"
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/schema"
+	broadcastsvc "LoudQuestionBot/internal/domain/service/broadcast"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+func (c *Controller) handleBroadcastCallback(ctx context.Context, chatID, userID int64, messageID int, data string, ack func(string, bool)) {
+	if !c.access.Can(userID, schema.PermissionBroadcast) {
+		ack(tr(ctx, "common.forbidden"), true)
+		return
+	}
+	parts := strings.Split(data, ":")
+	if len(parts) < 2 {
+		return
+	}
+	switch parts[1] {
+	case "menu":
+		c.sendBroadcastMenuWithMessage(ctx, chatID, messageID)
+		return
+	case "new":
+		_ = c.form.StartBroadcast(ctx, userID)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "broadcast.text_prompt", broadcastsvc.MaxTextLen)})
+		return
+	}
+
+	state, ok, err := c.form.Get(ctx, userID)
+	if err != nil || !ok || state.Mode != schema.FormModeBroadcast {
+		ack(tr(ctx, "common.form_expired"), true)
+		return
+	}
+	switch parts[1] {
+	case "x":
+		_ = c.form.Cancel(ctx, userID)
+		c.sendBroadcastMenuWithMessage(ctx, chatID, messageID)
+	case "text":
+		state.Step = schema.FormStepBroadcastText
+		_ = c.form.Save(ctx, userID, state)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "broadcast.text_prompt", broadcastsvc.MaxTextLen)})
+	case "aud":
+		if len(parts) < 3 {
+			c.sendBroadcastAudienceWithMessage(ctx, chatID, messageID)
+			return
+		}
+		switch parts[2] {
+		case "days":
+			state.Step = schema.FormStepBroadcastDays
+			_ = c.form.Save(ctx, userID, state)
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "broadcast.days_prompt", broadcastsvc.MaxActiveDays)})
+			return
+		case string(schema.BroadcastAudienceActive):
+			if len(parts) < 4 {
+				return
+			}
+			days, err := strconv.Atoi(parts[3])
+			if err != nil {
+				return
+			}
+			state.Broadcast.ActiveDays = days
+		}
+		state.Broadcast.Audience = schema.BroadcastAudience(parts[2])
+		if !state.Broadcast.Audience.Valid() {
+			return
+		}
+		state.Step = schema.FormStepBroadcastPreview
+		_ = c.form.Save(ctx, userID, state)
+		c.sendBroadcastPreviewWithMessage(ctx, chatID, state.Broadcast, messageID)
+	case "send":
+		if state.Step != schema.FormStepBroadcastPreview {
+			ack(tr(ctx, "broadcast.choose_audience_first"), true)
+			return
+		}
+		b, err := c.broadcasts.Launch(ctx, userID, state.Broadcast)
+		if err != nil {
+			switch {
+			case errors.Is(err, errorz.ErrNotFound):
+				ack(tr(ctx, "broadcast.no_recipients"), true)
+			case errors.Is(err, errorz.ErrInvalid), errors.Is(err, errorz.ErrLimitExceeded):
+				ack(tr(ctx, "broadcast.invalid"), true)
+			default:
+				log.Printf("launch broadcast: %v", err)
+				ack(tr(ctx, "broadcast.launch_failed"), true)
+			}
+			return
+		}
+		_ = c.form.Cancel(ctx, userID)
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Text:      tr(ctx, "broadcast.launched", i18n.Count(b.Stats.Total)),
+			ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+				{{Text: tr(ctx, "broadcast.button"), CallbackData: "bc:menu"}},
+			}},
+		})
+	}
+}
+
+func (c *Controller) handleBroadcastText(ctx context.Context, chatID, userID int64, state schema.FormState, text string) {
+	if !c.access.Can(userID, schema.PermissionBroadcast) {
+		_ = c.form.Cancel(ctx, userID)
+		return
+	}
+	switch state.Step {
+	case schema.FormStepBroadcastText:
+		if text == "" {
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "broadcast.text_empty")})
+			return
+		}
+		if utf8.RuneCountInString(text) > broadcastsvc.MaxTextLen {
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "broadcast.text_too_long", broadcastsvc.MaxTextLen)})
+			return
+		}
+		state.Broadcast.Text = text
+		if state.Broadcast.Audience.Valid() {
+			state.Step = schema.FormStepBroadcastPreview
+			_ = c.form.Save(ctx, userID, state)
+			c.sendBroadcastPreviewWithMessage(ctx, chatID, state.Broadcast, 0)
+			return
+		}
+		_ = c.form.Save(ctx, userID, state)
+		c.sendBroadcastAudienceWithMessage(ctx, chatID, 0)
+	case schema.FormStepBroadcastDays:
+		days, err := strconv.Atoi(text)
+		if err != nil || days < 1 || days > broadcastsvc.MaxActiveDays {
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "broadcast.days_invalid", broadcastsvc.MaxActiveDays)})
+			return
+		}
+		state.Broadcast.Audience = schema.BroadcastAudienceActive
+		state.Broadcast.ActiveDays = days
+		state.Step = schema.FormStepBroadcastPreview
+		_ = c.form.Save(ctx, userID, state)
+		c.sendBroadcastPreviewWithMessage(ctx, chatID, state.Broadcast, 0)
+	default:
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.use_buttons")})
+	}
+}
+
+func (c *Controller) sendBroadcastMenuWithMessage(ctx context.Context, chatID int64, messageID int) {
+	items, err := c.broadcasts.Recent(ctx, 5)
+	if err != nil {
+		log.Printf("recent broadcasts: %v", err)
+		return
+	}
+	lines := []string{tr(ctx, "broadcast.title")}
+	if len(items) == 0 {
+		lines = append(lines, "", tr(ctx, "broadcast.empty"))
+	} else {
+		lines = append(lines, "", tr(ctx, "broadcast.recent"))
+	}
+	for _, b := range items {
+		state := "✅"
+		if b.Status == schema.BroadcastStatusSending {
+			state = fmt.Sprintf("⏳ %d", b.Stats.Pending)
+		}
+		lines = append(lines, fmt.Sprintf(
+			"%s · %s · %s\n📬 %d 🚫 %d ⚠️ %d · %s",
+			b.CreatedAt.Format(tr(ctx, "layout.datetime_short")),
+			broadcastAudienceTitle(ctx, schema.BroadcastDraft{Audience: b.Audience, ActiveDays: b.ActiveDays}),
+			shortText(b.Text, 30),
+			b.Stats.Sent, b.Stats.Blocked, b.Stats.Failed, state,
+		))
+	}
+	lines = append(lines, "", tr(ctx, "broadcast.legend"))
+	text := strings.Join(lines, "\n")
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+		{{Text: tr(ctx, "broadcast.new"), CallbackData: "bc:new"}},
+		{{Text: tr(ctx, "common.refresh"), CallbackData: "bc:menu"}},
+		{{Text: tr(ctx, "common.back"), CallbackData: "adm:menu"}},
+	}}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func (c *Controller) sendBroadcastAudienceWithMessage(ctx context.Context, chatID int64, messageID int) {
+	text := tr(ctx, "broadcast.audience_prompt")
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+		{{Text: tr(ctx, "broadcast.audience_all_button"), CallbackData: "bc:aud:all"}},
+		{
+			{Text: tr(ctx, "broadcast.audience_7_button"), CallbackData: "bc:aud:active:7"},
+			{Text: tr(ctx, "broadcast.audience_30_button"), CallbackData: "bc:aud:active:30"},
+		},
+		{{Text: tr(ctx, "broadcast.audience_n_button"), CallbackData: "bc:aud:days"}},
+		{{Text: tr(ctx, "broadcast.audience_owners_button"), CallbackData: "bc:aud:owners"}},
+		{{Text: tr(ctx, "common.cancel"), CallbackData: "bc:x"}},
+	}}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func (c *Controller) sendBroadcastPreviewWithMessage(ctx context.Context, chatID int64, draft schema.BroadcastDraft, messageID int) {
+	recipients, err := c.broadcasts.AudienceSize(ctx, draft)
+	if err != nil {
+		log.Printf("broadcast audience: %v", err)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.error")})
+		return
+	}
+	text := tr(ctx, "broadcast.preview", broadcastAudienceTitle(ctx, draft), i18n.Count(recipients), draft.Text)
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+		{{Text: tr(ctx, "broadcast.send"), CallbackData: "bc:send"}},
+		{{Text: tr(ctx, "broadcast.edit_text"), CallbackData: "bc:text"}},
+		{{Text: tr(ctx, "broadcast.edit_audience"), CallbackData: "bc:aud"}},
+		{{Text: tr(ctx, "common.cancel"), CallbackData: "bc:x"}},
+	}}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
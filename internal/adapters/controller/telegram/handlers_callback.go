@@ -1,8 +1,10 @@
 package telegram
 
 import (
+	"LoudQuestionBot/internal/adapters/controller/telegram/i18n"
 	"LoudQuestionBot/internal/domain/errorz"
 	"LoudQuestionBot/internal/domain/schema"
+	adminsvc "LoudQuestionBot/internal/domain/service/admin"
 	gamesvc "LoudQuestionBot/internal/domain/service/game"
 	"context"
 	"errors"
@@ -39,31 +41,375 @@ func (c *Controller) handleCallback(ctx context.Context, upd *models.Update) {
 	}()
 
 	switch {
+	case strings.HasPrefix(data, "g:"):
+		c.handleGroupCallback(ctx, cb, ack)
+	case strings.HasPrefix(data, "mod:"):
+		c.handleModerationCallback(ctx, chatID, userID, messageID, data, ack)
+	case strings.HasPrefix(data, "rep:"):
+		c.handleReportCallback(ctx, chatID, userID, messageID, data, ack)
+	case strings.HasPrefix(data, "rvw:"):
+		c.handleReviewCallback(ctx, chatID, userID, messageID, data, ack)
+	case strings.HasPrefix(data, "bc:"):
+		c.handleBroadcastCallback(ctx, chatID, userID, messageID, data, ack)
+	case strings.HasPrefix(data, "day:"):
+		c.handleDailyCallback(ctx, chatID, userID, messageID, data, ack)
+	case strings.HasPrefix(data, "sub:"):
+		c.handleSubscriptionCallback(ctx, chatID, userID, messageID, data, ack)
+	case strings.HasPrefix(data, "frm:imp:"):
+		c.handleImportCallback(ctx, chatID, userID, data, ack)
+	case strings.HasPrefix(data, "pk:"):
+		c.handlePackCallback(ctx, chatID, userID, messageID, data, ack)
+	case strings.HasPrefix(data, "dup:"):
+		c.handleDuplicateCallback(ctx, chatID, userID, messageID, data, ack)
+	case strings.HasPrefix(data, "role:"):
+		c.handleRoleCallback(ctx, chatID, userID, messageID, data, ack)
+	case strings.HasPrefix(data, "sa:"):
+		c.handleManageCallback(ctx, chatID, userID, messageID, data, ack)
+	case strings.HasPrefix(data, "trash:"):
+		c.handleTrashCallback(ctx, chatID, userID, messageID, data, ack)
+	case strings.HasPrefix(data, "lang:"):
+		c.handleLanguageCallback(ctx, chatID, userID, messageID, data, ack)
+	case data == "sug:add":
+		_ = c.form.StartSuggest(ctx, userID, ctxLanguage(ctx))
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "suggest.intro")})
 	case data == "menu":
 		c.sendMenuWithMessage(ctx, chatID, userID, messageID)
 	case data == "profile:menu":
 		c.sendProfileMenuWithMessage(ctx, chatID, userID, messageID)
 	case data == "play":
 		c.sendNextQuestionFromCallback(ctx, chatID, userID, ack)
+	case data == "game:end":
+		c.endGame(ctx, chatID)
+	case data == "play:menu":
+		c.sendPlayMenuWithMessage(ctx, chatID, userID, messageID)
+	case data == "play:reset" || data == "play:reset:ok":
+		scope, canEdit, err := c.playScope(ctx, userID)
+		if err != nil {
+			log.Printf("play scope: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		if !canEdit {
+			ack(tr(ctx, "play.reset_owner_only"), true)
+			return
+		}
+		if data == "play:reset" {
+			text := tr(ctx, "play.reset_confirm_user")
+			if scope.IsTeam() {
+				text = tr(ctx, "play.reset_confirm_team")
+			}
+			_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+				ChatID:    chatID,
+				MessageID: messageID,
+				Text:      text + tr(ctx, "play.reset_note"),
+				ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+					{{Text: tr(ctx, "play.reset_yes"), CallbackData: "play:reset:ok"}},
+					{{Text: tr(ctx, "common.no"), CallbackData: "play:menu"}},
+				}},
+			})
+			return
+		}
+		archived, err := c.game.ResetProgress(ctx, scope)
+		if err != nil {
+			log.Printf("reset progress: %v", err)
+			ack(tr(ctx, "play.reset_failed"), true)
+			return
+		}
+		ack(tr(ctx, "play.reset_done", i18n.Count(archived)), true)
+		c.sendPlayMenuWithMessage(ctx, chatID, userID, messageID)
+	case data == "play:cats":
+		scope, canEdit, err := c.playScope(ctx, userID)
+		if err != nil {
+			log.Printf("play scope: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		settings, err := c.game.Settings(ctx, scope)
+		if err != nil {
+			log.Printf("play settings: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		c.sendCategoryPickerWithMessage(ctx, chatID, settings, canEdit, messageID)
+	case data == "play:langs":
+		scope, canEdit, err := c.playScope(ctx, userID)
+		if err != nil {
+			log.Printf("play scope: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		settings, err := c.game.Settings(ctx, scope)
+		if err != nil {
+			log.Printf("play settings: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		c.sendPlayLanguagePickerWithMessage(ctx, chatID, settings, canEdit, messageID)
+	case strings.HasPrefix(data, "play:lang:"), data == "play:fb":
+		scope, canEdit, err := c.playScope(ctx, userID)
+		if err != nil {
+			log.Printf("play scope: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		if !canEdit {
+			ack(tr(ctx, "play.language_owner_only"), true)
+			return
+		}
+		var settings schema.PlaySettings
+		if data == "play:fb" {
+			settings, err = c.game.ToggleLanguageFallback(ctx, scope)
+		} else {
+			key, _ := parseStringPart(data, 2)
+			if key == "auto" {
+				key = string(schema.LanguageAuto)
+			}
+			settings, err = c.game.SetLanguage(ctx, scope, schema.Language(key))
+		}
+		if err != nil {
+			if !errors.Is(err, errorz.ErrInvalid) {
+				log.Printf("set question language: %v", err)
+			}
+			ack(tr(ctx, "play.language_save_failed"), true)
+			return
+		}
+		c.sendPlayLanguagePickerWithMessage(ctx, chatID, settings, canEdit, messageID)
+	case data == "play:diffs":
+		scope, canEdit, err := c.playScope(ctx, userID)
+		if err != nil {
+			log.Printf("play scope: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		settings, err := c.game.Settings(ctx, scope)
+		if err != nil {
+			log.Printf("play settings: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		c.sendDifficultyPickerWithMessage(ctx, chatID, settings, canEdit, messageID)
+	case strings.HasPrefix(data, "play:diff:"):
+		key, ok := parseStringPart(data, 2)
+		if !ok {
+			return
+		}
+		scope, canEdit, err := c.playScope(ctx, userID)
+		if err != nil {
+			log.Printf("play scope: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		if !canEdit {
+			ack(tr(ctx, "play.difficulty_owner_only"), true)
+			return
+		}
+		settings, err := c.game.SetDifficulty(ctx, scope, schema.QuestionDifficulty(key))
+		if err != nil {
+			if !errors.Is(err, errorz.ErrInvalid) {
+				log.Printf("set difficulty: %v", err)
+			}
+			ack(tr(ctx, "play.difficulty_save_failed"), true)
+			return
+		}
+		c.sendDifficultyPickerWithMessage(ctx, chatID, settings, canEdit, messageID)
+	case strings.HasPrefix(data, "play:cat:"):
+		key, ok := parseStringPart(data, 2)
+		if !ok {
+			return
+		}
+		scope, canEdit, err := c.playScope(ctx, userID)
+		if err != nil {
+			log.Printf("play scope: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		if !canEdit {
+			ack(tr(ctx, "play.categories_owner_only"), true)
+			return
+		}
+		var settings schema.PlaySettings
+		if key == "all" {
+			settings, err = c.game.ResetCategories(ctx, scope)
+		} else {
+			settings, err = c.game.ToggleCategory(ctx, scope, schema.QuestionCategory(key))
+		}
+		if err != nil {
+			if !errors.Is(err, errorz.ErrInvalid) {
+				log.Printf("toggle category: %v", err)
+			}
+			ack(tr(ctx, "play.categories_save_failed"), true)
+			return
+		}
+		c.sendCategoryPickerWithMessage(ctx, chatID, settings, canEdit, messageID)
+	case data == "play:packs":
+		scope, canEdit, err := c.playScope(ctx, userID)
+		if err != nil {
+			log.Printf("play scope: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		settings, err := c.game.Settings(ctx, scope)
+		if err != nil {
+			log.Printf("play settings: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		c.sendPackPickerWithMessage(ctx, chatID, settings, canEdit, messageID)
+	case strings.HasPrefix(data, "play:pack:"):
+		key, ok := parseStringPart(data, 2)
+		if !ok || (key != "all" && !isValidUUID(key)) {
+			return
+		}
+		scope, canEdit, err := c.playScope(ctx, userID)
+		if err != nil {
+			log.Printf("play scope: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		if !canEdit {
+			ack(tr(ctx, "play.packs_owner_only"), true)
+			return
+		}
+		var settings schema.PlaySettings
+		if key == "all" {
+			settings, err = c.game.ResetPacks(ctx, scope)
+		} else {
+			settings, err = c.game.TogglePack(ctx, scope, key)
+		}
+		if err != nil {
+			if !errors.Is(err, errorz.ErrNotFound) {
+				log.Printf("toggle pack: %v", err)
+			}
+			ack(tr(ctx, "play.packs_save_failed"), true)
+			return
+		}
+		c.sendPackPickerWithMessage(ctx, chatID, settings, canEdit, messageID)
 	case strings.HasPrefix(data, "ans:"):
 		id, ok := parseStringPart(data, 1)
 		if !ok || !isValidUUID(id) {
 			return
 		}
-		answer, err := c.game.AnswerByQuestionID(ctx, id)
+		q, err := c.game.ActiveQuestionByID(ctx, id)
 		if err != nil {
 			if errors.Is(err, errorz.ErrNotFound) {
-				ack("Вопрос больше недоступен", true)
+				ack(tr(ctx, "question.unavailable"), true)
 				return
 			}
 			log.Printf("answer by id: %v", err)
-			ack("Ошибка. Попробуйте позже", true)
+			ack(tr(ctx, "common.error"), true)
 			return
 		}
 		if err := c.game.MarkAnsweredByUser(ctx, userID, id); err != nil {
 			log.Printf("mark answered by user: %v", err)
 		}
-		ack("Ответ: "+answer, true)
+		ack(tr(ctx, "label.answer")+q.AnswerText, true)
+		if err := c.session.RecordReveal(ctx, chatID, id); err != nil {
+			log.Printf("record session reveal: %v", err)
+		}
+		c.sendScorePrompt(ctx, chatID, userID, id)
+		if q.AuthorID != userID {
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+				ChatID:      chatID,
+				Text:        tr(ctx, "rating.prompt"),
+				ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{ratingButtons(q.ID)}},
+			})
+		}
+	case strings.HasPrefix(data, "rate:"):
+		qid, ok := parseStringPart(data, 1)
+		if !ok || !isValidUUID(qid) {
+			return
+		}
+		key, _ := parseStringPart(data, 2)
+		value := schema.RatingUp
+		if key == "down" {
+			value = schema.RatingDown
+		}
+		if err := c.game.RateQuestion(ctx, userID, qid, value); err != nil {
+			switch {
+			case errors.Is(err, errorz.ErrForbidden):
+				ack(tr(ctx, "rating.own"), true)
+			case errors.Is(err, errorz.ErrNotFound):
+				ack(tr(ctx, "question.unavailable"), true)
+			default:
+				log.Printf("rate question: %v", err)
+				ack(tr(ctx, "rating.failed"), true)
+			}
+			return
+		}
+		if cb.Message.Message.Chat.Type != models.ChatTypePrivate {
+			ack(tr(ctx, "rating.thanks"), false)
+			return
+		}
+		mark := "👍"
+		if value == schema.RatingDown {
+			mark = "👎"
+		}
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Text:      tr(ctx, "rating.thanks_with") + mark,
+		})
+	case strings.HasPrefix(data, "sc:"):
+		qid, ok := parseStringPart(data, 1)
+		if !ok || !isValidUUID(qid) {
+			return
+		}
+		winnerID, ok := parseInt64Part(data, 2)
+		if !ok {
+			return
+		}
+		t, inTeam, err := c.team.GetByUserID(ctx, userID)
+		if err != nil {
+			log.Printf("team by user: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		if !inTeam {
+			ack(tr(ctx, "team.not_member"), true)
+			return
+		}
+		members, err := c.team.Members(ctx, t.ID)
+		if err != nil {
+			log.Printf("team members: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		winnerName := ""
+		for _, m := range members {
+			if m.UserID == winnerID {
+				winnerName = memberName(ctx, m)
+				break
+			}
+		}
+		if winnerID != 0 && winnerName == "" {
+			ack(tr(ctx, "team.member_not_found"), true)
+			return
+		}
+		if err := c.game.ScoreTeamQuestion(ctx, t.ID, qid, userID, winnerID); err != nil {
+			switch {
+			case errors.Is(err, errorz.ErrAlreadyExists):
+				ack(tr(ctx, "score.already"), true)
+				return
+			case errors.Is(err, errorz.ErrNotFound):
+				ack(tr(ctx, "question.unavailable"), true)
+				return
+			}
+			log.Printf("score team question: %v", err)
+			ack(tr(ctx, "score.failed"), true)
+			return
+		}
+		if err := c.session.RecordScore(ctx, chatID, qid, winnerID); err != nil {
+			log.Printf("record session score: %v", err)
+		}
+		text := tr(ctx, "score.nobody")
+		if winnerID != 0 {
+			text = tr(ctx, "score.winner") + winnerName
+		}
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Text:      text,
+		})
 	case data == "team:menu":
 		c.sendTeamMenuWithMessage(ctx, chatID, userID, messageID)
 	case data == "team:create":
@@ -71,26 +417,26 @@ func (c *Controller) handleCallback(ctx context.Context, upd *models.Update) {
 		if err != nil {
 			switch {
 			case errors.Is(err, errorz.ErrConflict):
-				ack("Вы уже состоите в команде", true)
+				ack(tr(ctx, "team.already_member"), true)
 			default:
 				log.Printf("team create: %v", err)
-				ack("Не удалось создать команду", true)
+				ack(tr(ctx, "team.create_failed"), true)
 			}
 			return
 		}
 		c.sendTeamMenuWithMessage(ctx, chatID, userID, messageID)
 	case data == "team:join:help":
-		ack("Введите код: /jointeam <uuid>", true)
+		ack(tr(ctx, "team.join_prompt"), true)
 	case data == "team:leave":
 		err := c.team.Leave(ctx, userID)
 		if err != nil {
 			if !errors.Is(err, errorz.ErrNotFound) {
 				log.Printf("team leave: %v", err)
 			}
-			ack("Вы не состоите в команде", true)
+			ack(tr(ctx, "team.not_member"), true)
 			return
 		}
-		ack("Вы вышли из команды", true)
+		ack(tr(ctx, "team.left"), true)
 		c.sendTeamMenuWithMessage(ctx, chatID, userID, messageID)
 	case data == "team:link":
 		c.sendTeamInvite(ctx, chatID, userID)
@@ -107,16 +453,16 @@ func (c *Controller) handleCallback(ctx context.Context, upd *models.Update) {
 		if err != nil {
 			switch {
 			case errors.Is(err, errorz.ErrForbidden):
-				ack("Кикать может только создатель", true)
+				ack(tr(ctx, "team.kick_owner_only"), true)
 			case errors.Is(err, errorz.ErrNotFound):
-				ack("Участник не найден", true)
+				ack(tr(ctx, "team.player_not_found"), true)
 			default:
 				log.Printf("team kick: %v", err)
-				ack("Не удалось кикнуть участника", true)
+				ack(tr(ctx, "team.kick_failed"), true)
 			}
 			return
 		}
-		ack("Участник кикнут", true)
+		ack(tr(ctx, "team.kicked"), true)
 		c.sendTeamMembersWithMessage(ctx, chatID, userID, messageID)
 	case strings.HasPrefix(data, "team:owner:"):
 		memberID, ok := parseInt64Part(data, 2)
@@ -127,40 +473,46 @@ func (c *Controller) handleCallback(ctx context.Context, upd *models.Update) {
 		if err != nil {
 			switch {
 			case errors.Is(err, errorz.ErrForbidden):
-				ack("Передавать может только создатель", true)
+				ack(tr(ctx, "team.transfer_owner_only"), true)
 			case errors.Is(err, errorz.ErrNotFound):
-				ack("Участник не найден в команде", true)
+				ack(tr(ctx, "team.member_not_found"), true)
 			default:
 				log.Printf("team transfer ownership: %v", err)
-				ack("Не удалось передать админа", true)
+				ack(tr(ctx, "team.transfer_failed"), true)
 			}
 			return
 		}
-		ack("Админ передан", true)
+		ack(tr(ctx, "team.transferred"), true)
 		c.sendTeamMenuWithMessage(ctx, chatID, userID, messageID)
+	case strings.HasPrefix(data, "adm:hist:") || strings.HasPrefix(data, "adm:rv:"):
+		c.handleHistoryCallback(ctx, chatID, userID, messageID, data, ack)
+	case data == "adm:find" || strings.HasPrefix(data, "adm:fnd:"):
+		c.handleSearchCallback(ctx, chatID, userID, messageID, data, ack)
+	case data == "adm:exp" || strings.HasPrefix(data, "adm:exp:"):
+		c.handleExportCallback(ctx, chatID, userID, data, ack)
 	case data == "adm:menu":
-		if !c.access.IsAdmin(userID) {
-			ack("Недостаточно прав", true)
+		if !c.access.IsStaff(userID) {
+			ack(tr(ctx, "common.forbidden"), true)
 			return
 		}
 		c.sendAdminMenuWithMessage(ctx, chatID, messageID)
 	case data == "adm:add":
-		if !c.access.IsAdmin(userID) {
+		if !c.access.Can(userID, schema.PermissionAuthor) {
 			return
 		}
-		_ = c.form.StartCreate(ctx, userID)
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Напишите вопрос"})
+		_ = c.form.StartCreate(ctx, userID, ctxLanguage(ctx))
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.question_prompt")})
 	case data == "adm:pool":
-		if !c.access.IsAdmin(userID) {
+		if !c.access.Can(userID, schema.PermissionAuthor) {
 			return
 		}
-		_ = c.form.StartPoolCreate(ctx, userID)
+		_ = c.form.StartPoolCreate(ctx, userID, ctxLanguage(ctx), "")
 		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
 			ChatID: chatID,
-			Text:   "Отправьте пулл вопросов (до 25) в формате:\n[2+2]-[4]\n[4+2]-[6]\n\nДля экстренной остановки: /stop",
+			Text:   tr(ctx, "pool.prompt"),
 		})
 	case strings.HasPrefix(data, "adm:list:"):
-		if !c.access.IsAdmin(userID) {
+		if !c.access.Can(userID, schema.PermissionAuthor) {
 			return
 		}
 		page, ok := parseIntPart(data, 2)
@@ -169,7 +521,7 @@ func (c *Controller) handleCallback(ctx context.Context, upd *models.Update) {
 		}
 		c.sendMyQuestions(ctx, chatID, userID, page)
 	case strings.HasPrefix(data, "adm:open:"):
-		if !c.access.IsAdmin(userID) {
+		if !c.access.Can(userID, schema.PermissionAuthor) {
 			return
 		}
 		parts := strings.Split(data, ":")
@@ -183,7 +535,7 @@ func (c *Controller) handleCallback(ctx context.Context, upd *models.Update) {
 		}
 		c.sendQuestionCard(ctx, chatID, userID, qid, page)
 	case strings.HasPrefix(data, "adm:edit:"):
-		if !c.access.IsAdmin(userID) {
+		if !c.access.Can(userID, schema.PermissionAuthor) {
 			return
 		}
 		parts := strings.Split(data, ":")
@@ -197,17 +549,49 @@ func (c *Controller) handleCallback(ctx context.Context, upd *models.Update) {
 		}
 		q, err := c.admin.GetQuestion(ctx, qid)
 		if err != nil {
-			ack("Вопрос больше недоступен", true)
+			ack(tr(ctx, "question.unavailable"), true)
 			return
 		}
-		if q.AuthorID != userID || q.Status != schema.QuestionStatusActive {
-			ack("Можно редактировать только свои", true)
+		if q.AuthorID != userID || !q.Status.VisibleToAuthor() {
+			ack(tr(ctx, "question.edit_own_only"), true)
 			return
 		}
-		_ = c.form.StartEdit(ctx, userID, q.ID, page, schema.QuestionDraft{QuestionText: q.QuestionText, AnswerText: q.AnswerText})
+		_ = c.form.StartEdit(ctx, userID, q.ID, page, schema.QuestionDraft{QuestionText: q.QuestionText, AnswerText: q.AnswerText, Category: q.Category, Difficulty: q.Difficulty, Language: q.Language})
 		c.sendChooseField(ctx, chatID)
+	case strings.HasPrefix(data, "adm:tr:"):
+		if !c.access.Can(userID, schema.PermissionAuthor) {
+			return
+		}
+		parts := strings.Split(data, ":")
+		if len(parts) < 5 {
+			return
+		}
+		qid := parts[2]
+		lang := schema.Language(parts[3])
+		if !isValidUUID(qid) || lang == schema.LanguageAuto || !lang.Valid() {
+			return
+		}
+		q, err := c.admin.GetQuestion(ctx, qid)
+		if err != nil || q.Status != schema.QuestionStatusActive {
+			ack(tr(ctx, "question.unavailable"), true)
+			return
+		}
+		if q.AuthorID != userID {
+			ack(tr(ctx, "question.not_yours"), true)
+			return
+		}
+		if q.Language == lang {
+			ack(tr(ctx, "question.translation_exists"), true)
+			return
+		}
+		if err := c.form.StartTranslation(ctx, userID, q, lang); err != nil {
+			log.Printf("start translation: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.translation_prompt", languageTitle(ctx, lang))})
 	case strings.HasPrefix(data, "adm:delask:"):
-		if !c.access.IsAdmin(userID) {
+		if !c.access.Can(userID, schema.PermissionAuthor) {
 			return
 		}
 		parts := strings.Split(data, ":")
@@ -221,14 +605,14 @@ func (c *Controller) handleCallback(ctx context.Context, upd *models.Update) {
 		}
 		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
 			ChatID: chatID,
-			Text:   "Точно удалить вопрос? Он исчезнет у всех игроков.",
+			Text:   tr(ctx, "question.delete_confirm"),
 			ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
-				{{Text: "✅ Да, удалить", CallbackData: "adm:del:" + qid + ":" + page}},
-				{{Text: "❌ Нет, отмена", CallbackData: "adm:open:" + qid + ":" + page}},
+				{{Text: tr(ctx, "question.delete_yes"), CallbackData: "adm:del:" + qid + ":" + page}},
+				{{Text: tr(ctx, "question.delete_no"), CallbackData: "adm:open:" + qid + ":" + page}},
 			}},
 		})
 	case strings.HasPrefix(data, "adm:del:"):
-		if !c.access.IsAdmin(userID) {
+		if !c.access.Can(userID, schema.PermissionAuthor) {
 			return
 		}
 		parts := strings.Split(data, ":")
@@ -243,22 +627,34 @@ func (c *Controller) handleCallback(ctx context.Context, upd *models.Update) {
 		err = c.admin.DeleteQuestion(ctx, userID, qid)
 		if err != nil {
 			if errors.Is(err, errorz.ErrForbidden) {
-				ack("Можно удалять только свои", true)
+				ack(tr(ctx, "question.delete_own_only"), true)
 				return
 			}
 			log.Printf("delete question: %v", err)
-			ack("Не удалось удалить вопрос", true)
+			ack(tr(ctx, "question.delete_failed"), true)
 			return
 		}
-		ack("Удалено", true)
+		ack(tr(ctx, "question.deleted"), true)
 		c.sendMyQuestions(ctx, chatID, userID, page)
 	case data == "frm:x":
+		state, _, _ := c.form.Get(ctx, userID)
 		_ = c.form.Cancel(ctx, userID)
-		c.sendAdminMenuWithMessage(ctx, chatID, messageID)
+		switch state.Mode {
+		case schema.FormModeSuggest:
+			c.sendMenuWithMessage(ctx, chatID, userID, messageID)
+		case schema.FormModeModerate:
+			c.sendModerationListWithMessage(ctx, chatID, state.Page, messageID)
+		case schema.FormModeReview:
+			c.sendReportListWithMessage(ctx, chatID, state.Page, messageID)
+		case schema.FormModeManage:
+			c.sendManagedQuestionsWithMessage(ctx, chatID, state.AuthorID, state.Page, messageID)
+		default:
+			c.sendAdminMenuWithMessage(ctx, chatID, messageID)
+		}
 	case data == "frm:e":
 		state, ok, err := c.form.Get(ctx, userID)
 		if err != nil || !ok {
-			ack("Форма устарела, начните заново", true)
+			ack(tr(ctx, "common.form_expired"), true)
 			return
 		}
 		state.Step = schema.FormStepChooseField
@@ -267,7 +663,7 @@ func (c *Controller) handleCallback(ctx context.Context, upd *models.Update) {
 	case data == "frm:b":
 		state, ok, err := c.form.Get(ctx, userID)
 		if err != nil || !ok {
-			ack("Форма устарела, начните заново", true)
+			ack(tr(ctx, "common.form_expired"), true)
 			return
 		}
 		state.Step = schema.FormStepPreview
@@ -276,63 +672,180 @@ func (c *Controller) handleCallback(ctx context.Context, upd *models.Update) {
 	case data == "frm:f:q" || data == "frm:f:a":
 		state, ok, err := c.form.Get(ctx, userID)
 		if err != nil || !ok {
-			ack("Форма устарела, начните заново", true)
+			ack(tr(ctx, "common.form_expired"), true)
 			return
 		}
 		switch data {
 		case "frm:f:q":
 			state.Field = schema.FormFieldQuestion
-			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Введите новый текст вопроса"})
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.new_question_prompt")})
 		case "frm:f:a":
 			state.Field = schema.FormFieldAnswer
-			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Введите новый текст ответа"})
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.new_answer_prompt")})
 		}
 		state.Step = schema.FormStepEditInput
 		_ = c.form.Save(ctx, userID, state)
-	case data == "frm:c":
+	case data == "frm:f:c":
 		state, ok, err := c.form.Get(ctx, userID)
 		if err != nil || !ok {
-			ack("Форма устарела, начните заново", true)
+			ack(tr(ctx, "common.form_expired"), true)
+			return
+		}
+		state.Field = schema.FormFieldCategory
+		state.Step = schema.FormStepCategory
+		_ = c.form.Save(ctx, userID, state)
+		c.sendCategoryChooser(ctx, chatID)
+	case strings.HasPrefix(data, "frm:cat:"):
+		state, ok, err := c.form.Get(ctx, userID)
+		if err != nil || !ok || state.Step != schema.FormStepCategory {
+			ack(tr(ctx, "common.form_expired"), true)
+			return
+		}
+		key, _ := parseStringPart(data, 2)
+		category := schema.QuestionCategory(key)
+		if !category.Valid() {
+			return
+		}
+		state.Draft.Category = category
+		if (state.Mode == schema.FormModeCreate || state.Mode == schema.FormModeSuggest) && state.Draft.Difficulty == "" {
+			state.Step = schema.FormStepDifficulty
+			_ = c.form.Save(ctx, userID, state)
+			c.sendDifficultyChooser(ctx, chatID)
+			return
+		}
+		state.Step = schema.FormStepPreview
+		_ = c.form.Save(ctx, userID, state)
+		c.sendDraftPreview(ctx, chatID, state)
+	case data == "frm:f:d":
+		state, ok, err := c.form.Get(ctx, userID)
+		if err != nil || !ok {
+			ack(tr(ctx, "common.form_expired"), true)
+			return
+		}
+		state.Field = schema.FormFieldDifficulty
+		state.Step = schema.FormStepDifficulty
+		_ = c.form.Save(ctx, userID, state)
+		c.sendDifficultyChooser(ctx, chatID)
+	case data == "frm:f:l":
+		state, ok, err := c.form.Get(ctx, userID)
+		if err != nil || !ok {
+			ack(tr(ctx, "common.form_expired"), true)
+			return
+		}
+		state.Field = schema.FormFieldLanguage
+		state.Step = schema.FormStepLanguage
+		_ = c.form.Save(ctx, userID, state)
+		c.sendLanguageChooser(ctx, chatID)
+	case strings.HasPrefix(data, "frm:lng:"):
+		state, ok, err := c.form.Get(ctx, userID)
+		if err != nil || !ok || state.Step != schema.FormStepLanguage {
+			ack(tr(ctx, "common.form_expired"), true)
+			return
+		}
+		key, _ := parseStringPart(data, 2)
+		lang := schema.Language(key)
+		if lang == schema.LanguageAuto || !lang.Valid() {
+			return
+		}
+		state.Draft.Language = lang
+		state.Step = schema.FormStepPreview
+		_ = c.form.Save(ctx, userID, state)
+		c.sendDraftPreview(ctx, chatID, state)
+	case strings.HasPrefix(data, "frm:dif:"):
+		state, ok, err := c.form.Get(ctx, userID)
+		if err != nil || !ok || state.Step != schema.FormStepDifficulty {
+			ack(tr(ctx, "common.form_expired"), true)
+			return
+		}
+		key, _ := parseStringPart(data, 2)
+		difficulty := schema.QuestionDifficulty(key)
+		if !difficulty.Valid() {
+			return
+		}
+		state.Draft.Difficulty = difficulty
+		state.Step = schema.FormStepPreview
+		_ = c.form.Save(ctx, userID, state)
+		c.sendDraftPreview(ctx, chatID, state)
+	case data == "frm:c" || data == "frm:c:f":
+		state, ok, err := c.form.Get(ctx, userID)
+		if err != nil || !ok {
+			ack(tr(ctx, "common.form_expired"), true)
+			return
+		}
+		if state.Mode == schema.FormModeSuggest {
+			q, err := c.admin.SubmitQuestion(ctx, userID, state.Draft)
+			if err != nil {
+				switch {
+				case errors.Is(err, adminsvc.ErrTooManyPending):
+					ack(tr(ctx, "suggest.too_many", adminsvc.MaxPendingPerAuthor), true)
+					return
+				case errors.Is(err, errorz.ErrLimitExceeded):
+					ack(tr(ctx, "form.limit"), true)
+					return
+				case errors.Is(err, errorz.ErrInvalid):
+					ack(tr(ctx, "form.text_empty"), true)
+					return
+				}
+				log.Printf("submit question: %v", err)
+				ack(tr(ctx, "suggest.failed"), true)
+				return
+			}
+			_ = c.form.Cancel(ctx, userID)
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "suggest.sent")})
+			c.sendMenu(ctx, chatID, userID)
+			c.notifyModerators(ctx, q)
 			return
 		}
 		if state.Mode != schema.FormModeCreate {
 			return
 		}
-		_, err = c.admin.CreateQuestion(ctx, userID, state.Draft)
+		_, err = c.admin.CreateQuestion(ctx, userID, state.Draft, data == "frm:c:f")
 		if err != nil {
-			if errors.Is(err, errorz.ErrLimitExceeded) {
-				ack("Лимит 250 символов на вопрос и ответ", true)
+			var dup *adminsvc.DuplicateError
+			switch {
+			case errors.As(err, &dup):
+				c.sendDuplicateWarning(ctx, chatID, dup.Matches)
+				return
+			case errors.Is(err, errorz.ErrLimitExceeded):
+				ack(tr(ctx, "form.limit"), true)
+				return
+			case errors.Is(err, errorz.ErrAlreadyExists):
+				ack(tr(ctx, "question.translation_exists"), true)
+				return
+			case errors.Is(err, errorz.ErrNotFound):
+				_ = c.form.Cancel(ctx, userID)
+				ack(tr(ctx, "question.unavailable"), true)
 				return
 			}
 			log.Printf("create question: %v", err)
-			ack("Не удалось сохранить вопрос", true)
+			ack(tr(ctx, "form.save_failed"), true)
 			return
 		}
 		_ = c.form.Cancel(ctx, userID)
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "✅ Вопрос добавлен"})
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.created")})
 		c.sendAdminMenu(ctx, chatID)
 	case data == "frm:p:e":
 		state, ok, err := c.form.Get(ctx, userID)
 		if err != nil || !ok || state.Step != schema.FormStepPoolPreview {
-			ack("Форма устарела, начните заново", true)
+			ack(tr(ctx, "common.form_expired"), true)
 			return
 		}
 		state.Step = schema.FormStepPoolEditQ
 		_ = c.form.Save(ctx, userID, state)
 		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
 			ChatID: chatID,
-			Text:   "Введите вопрос",
+			Text:   tr(ctx, "form.enter_question"),
 		})
 	case data == "frm:p:x":
 		state, ok, err := c.form.Get(ctx, userID)
 		if err != nil || !ok || state.Step != schema.FormStepPoolPreview {
-			ack("Форма устарела, начните заново", true)
+			ack(tr(ctx, "common.form_expired"), true)
 			return
 		}
 		state.PoolIndex++
 		if state.PoolIndex >= len(state.PoolItems) {
 			_ = c.form.Cancel(ctx, userID)
-			ack(fmt.Sprintf("Пулл завершен. Добавлено: %d из %d", state.PoolSaved, len(state.PoolItems)), true)
+			ack(tr(ctx, "pool.done", state.PoolSaved, len(state.PoolItems)), true)
 			c.sendAdminMenu(ctx, chatID)
 			return
 		}
@@ -341,21 +854,21 @@ func (c *Controller) handleCallback(ctx context.Context, upd *models.Update) {
 	case data == "frm:p:c":
 		state, ok, err := c.form.Get(ctx, userID)
 		if err != nil || !ok || state.Step != schema.FormStepPoolPreview {
-			ack("Форма устарела, начните заново", true)
+			ack(tr(ctx, "common.form_expired"), true)
 			return
 		}
 		if state.PoolIndex < 0 || state.PoolIndex >= len(state.PoolItems) {
-			ack("Форма устарела, начните заново", true)
+			ack(tr(ctx, "common.form_expired"), true)
 			_ = c.form.Cancel(ctx, userID)
 			return
 		}
-		_, err = c.admin.CreateQuestion(ctx, userID, state.PoolItems[state.PoolIndex])
+		_, err = c.admin.CreateQuestion(ctx, userID, state.PoolItems[state.PoolIndex], true)
 		if err != nil {
 			if errors.Is(err, errorz.ErrLimitExceeded) {
-				ack("Лимит 250 символов на вопрос и ответ", true)
+				ack(tr(ctx, "form.limit"), true)
 			} else {
 				log.Printf("create pool question: %v", err)
-				ack("Не удалось сохранить вопрос", true)
+				ack(tr(ctx, "form.save_failed"), true)
 			}
 			return
 		}
@@ -363,7 +876,7 @@ func (c *Controller) handleCallback(ctx context.Context, upd *models.Update) {
 		state.PoolIndex++
 		if state.PoolIndex >= len(state.PoolItems) {
 			_ = c.form.Cancel(ctx, userID)
-			ack(fmt.Sprintf("Пулл завершен. Добавлено: %d из %d", state.PoolSaved, len(state.PoolItems)), true)
+			ack(tr(ctx, "pool.done", state.PoolSaved, len(state.PoolItems)), true)
 			c.sendAdminMenu(ctx, chatID)
 			return
 		}
@@ -372,7 +885,75 @@ func (c *Controller) handleCallback(ctx context.Context, upd *models.Update) {
 	case data == "frm:s":
 		state, ok, err := c.form.Get(ctx, userID)
 		if err != nil || !ok {
-			ack("Форма устарела, начните заново", true)
+			ack(tr(ctx, "common.form_expired"), true)
+			return
+		}
+		if state.Mode == schema.FormModeModerate {
+			if !c.access.Can(userID, schema.PermissionModerate) {
+				return
+			}
+			q, err := c.admin.UpdatePendingQuestion(ctx, userID, state.QuestionID, state.Draft)
+			if err != nil {
+				switch {
+				case errors.Is(err, errorz.ErrNotFound):
+					_ = c.form.Cancel(ctx, userID)
+					ack(tr(ctx, "moderation.already_processed"), true)
+				case errors.Is(err, errorz.ErrLimitExceeded):
+					ack(tr(ctx, "form.limit"), true)
+				default:
+					log.Printf("update pending question: %v", err)
+					ack(tr(ctx, "form.update_failed"), true)
+				}
+				return
+			}
+			_ = c.form.Cancel(ctx, userID)
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.updated")})
+			c.sendModerationCardWithMessage(ctx, chatID, q, state.Page, 0)
+			return
+		}
+		if state.Mode == schema.FormModeReview {
+			if !c.access.Can(userID, schema.PermissionModerate) {
+				return
+			}
+			if _, err := c.admin.EditReportedQuestion(ctx, userID, state.QuestionID, state.Draft); err != nil {
+				switch {
+				case errors.Is(err, errorz.ErrNotFound):
+					_ = c.form.Cancel(ctx, userID)
+					ack(tr(ctx, "question.unavailable"), true)
+				case errors.Is(err, errorz.ErrLimitExceeded):
+					ack(tr(ctx, "form.limit"), true)
+				default:
+					log.Printf("edit reported question: %v", err)
+					ack(tr(ctx, "form.update_failed"), true)
+				}
+				return
+			}
+			_ = c.form.Cancel(ctx, userID)
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "review.updated")})
+			c.sendReportListWithMessage(ctx, chatID, state.Page, 0)
+			return
+		}
+		if state.Mode == schema.FormModeManage {
+			if !c.access.Can(userID, schema.PermissionEditOthers) {
+				return
+			}
+			q, err := c.admin.EditAnyQuestion(ctx, userID, state.QuestionID, state.Draft)
+			if err != nil {
+				switch {
+				case errors.Is(err, errorz.ErrNotFound):
+					_ = c.form.Cancel(ctx, userID)
+					ack(tr(ctx, "question.unavailable"), true)
+				case errors.Is(err, errorz.ErrLimitExceeded):
+					ack(tr(ctx, "form.limit"), true)
+				default:
+					log.Printf("edit managed question: %v", err)
+					ack(tr(ctx, "form.update_failed"), true)
+				}
+				return
+			}
+			_ = c.form.Cancel(ctx, userID)
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.updated")})
+			c.sendManagedQuestionCardWithMessage(ctx, chatID, q, state.AuthorID, state.Page, 0)
 			return
 		}
 		if state.Mode != schema.FormModeEdit {
@@ -381,28 +962,31 @@ func (c *Controller) handleCallback(ctx context.Context, upd *models.Update) {
 		q, err := c.admin.UpdateQuestion(ctx, userID, state.QuestionID, state.Draft)
 		if err != nil {
 			if errors.Is(err, errorz.ErrForbidden) {
-				ack("Можно редактировать только свои", true)
+				ack(tr(ctx, "question.edit_own_only"), true)
 				return
 			}
 			if errors.Is(err, errorz.ErrLimitExceeded) {
-				ack("Лимит 250 символов на вопрос и ответ", true)
+				ack(tr(ctx, "form.limit"), true)
 				return
 			}
 			log.Printf("update question: %v", err)
-			ack("Не удалось обновить вопрос", true)
+			ack(tr(ctx, "form.update_failed"), true)
 			return
 		}
 		_ = c.form.Cancel(ctx, userID)
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "✅ Обновлено"})
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.updated")})
 		c.sendQuestionCardWithEntity(ctx, chatID, q, state.Page)
 	}
 }
 
 func (c *Controller) sendNextQuestion(ctx context.Context, chatID, userID int64) {
-	q, err := c.nextQuestion(ctx, userID)
+	if c.postSummaryIfLimitReached(ctx, chatID) {
+		return
+	}
+	q, err := c.drawQuestion(ctx, chatID, userID)
 	if err != nil {
 		if errors.Is(err, gamesvc.ErrNoNewQuestions) {
-			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Нет новых вопросов", ReplyMarkup: c.mainMenu(userID)})
+			c.sendPoolExhausted(ctx, chatID)
 			return
 		}
 		log.Printf("next question: %v", err)
@@ -410,41 +994,108 @@ func (c *Controller) sendNextQuestion(ctx context.Context, chatID, userID int64)
 	}
 
 	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
-		ChatID: chatID,
-		Text:   "Вопрос:\n" + q.QuestionText,
-		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
-			{{Text: "Показать ответ", CallbackData: fmt.Sprintf("ans:%s", q.ID)}},
-			{{Text: "Следующий вопрос", CallbackData: "play"}},
-		}},
+		ChatID:      chatID,
+		Text:        tr(ctx, "game.question_prefix") + q.QuestionText,
+		ReplyMarkup: questionMarkup(ctx, q),
 	})
 }
 
 func (c *Controller) sendNextQuestionFromCallback(ctx context.Context, chatID, userID int64, ack func(string, bool)) {
-	q, err := c.nextQuestion(ctx, userID)
+	if c.postSummaryIfLimitReached(ctx, chatID) {
+		return
+	}
+	q, err := c.drawQuestion(ctx, chatID, userID)
 	if err != nil {
 		if errors.Is(err, gamesvc.ErrNoNewQuestions) {
-			ack("Нет новых вопросов", true)
+			c.sendPoolExhausted(ctx, chatID)
 			return
 		}
 		log.Printf("next question: %v", err)
-		ack("Ошибка. Попробуйте позже", true)
+		ack(tr(ctx, "common.error"), true)
 		return
 	}
 
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        tr(ctx, "game.question_prefix") + q.QuestionText,
+		ReplyMarkup: questionMarkup(ctx, q),
+	})
+}
+
+func (c *Controller) sendPoolExhausted(ctx context.Context, chatID int64) {
 	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
 		ChatID: chatID,
-		Text:   "Вопрос:\n" + q.QuestionText,
+		Text:   tr(ctx, "play.exhausted"),
 		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
-			{{Text: "Показать ответ", CallbackData: fmt.Sprintf("ans:%s", q.ID)}},
-			{{Text: "Следующий вопрос", CallbackData: "play"}},
+			{{Text: tr(ctx, "sub.notify_button"), CallbackData: "sub:on"}},
+			{{Text: tr(ctx, "play.reset_button"), CallbackData: "play:reset"}},
+			{{Text: tr(ctx, "play.settings_button"), CallbackData: "play:menu"}},
 		}},
 	})
 }
 
-func (c *Controller) nextQuestion(ctx context.Context, userID int64) (schema.Question, error) {
+func questionMarkup(ctx context.Context, q schema.Question) *models.InlineKeyboardMarkup {
+	return &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+		{
+			{Text: tr(ctx, "game.show_answer"), CallbackData: fmt.Sprintf("ans:%s", q.ID)},
+			{Text: tr(ctx, "report.button"), CallbackData: fmt.Sprintf("rep:%s", q.ID)},
+		},
+		{{Text: tr(ctx, "game.next"), CallbackData: "play"}},
+		{{Text: tr(ctx, "game.end_button"), CallbackData: "game:end"}},
+	}}
+}
+
+func ratingButtons(questionID string) []models.InlineKeyboardButton {
+	return []models.InlineKeyboardButton{
+		{Text: "👍", CallbackData: "rate:" + questionID + ":up"},
+		{Text: "👎", CallbackData: "rate:" + questionID + ":down"},
+	}
+}
+
+func (c *Controller) drawQuestion(ctx context.Context, chatID, userID int64) (schema.Question, error) {
 	teamID := ""
 	if t, ok, err := c.team.GetByUserID(ctx, userID); err == nil && ok {
 		teamID = t.ID
 	}
-	return c.game.NextQuestion(ctx, userID, teamID)
+	q, err := c.game.NextQuestion(ctx, userID, teamID, ctxLanguage(ctx))
+	if err != nil {
+		return schema.Question{}, err
+	}
+	if err := c.session.RecordDraw(ctx, chatID, userID, teamID, q.ID); err != nil {
+		log.Printf("record session draw: %v", err)
+	}
+	return q, nil
+}
+
+func (c *Controller) sendScorePrompt(ctx context.Context, chatID, userID int64, questionID string) {
+	t, inTeam, err := c.team.GetByUserID(ctx, userID)
+	if err != nil || !inTeam {
+		return
+	}
+	scored, err := c.game.IsScoredByTeam(ctx, t.ID, questionID)
+	if err != nil {
+		log.Printf("is scored by team: %v", err)
+		return
+	}
+	if scored {
+		return
+	}
+	members, err := c.team.Members(ctx, t.ID)
+	if err != nil {
+		log.Printf("team members: %v", err)
+		return
+	}
+	rows := make([][]models.InlineKeyboardButton, 0, len(members)+1)
+	for _, m := range members {
+		rows = append(rows, []models.InlineKeyboardButton{{
+			Text:         shortText(memberName(ctx, m), 40),
+			CallbackData: fmt.Sprintf("sc:%s:%d", questionID, m.UserID),
+		}})
+	}
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "score.nobody_button"), CallbackData: fmt.Sprintf("sc:%s:0", questionID)}})
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        tr(ctx, "score.prompt"),
+		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: rows},
+	})
 }
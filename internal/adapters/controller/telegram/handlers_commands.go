@@ -1,11 +1,11 @@
 package telegram
 
 import (
+	"LoudQuestionBot/internal/adapters/controller/telegram/i18n"
 	"LoudQuestionBot/internal/domain/errorz"
 	"LoudQuestionBot/internal/domain/schema"
 	"context"
 	"errors"
-	"fmt"
 	"strconv"
 	"strings"
 
@@ -32,14 +32,15 @@ func (c *Controller) start(ctx context.Context, b *tgbot.Bot, upd *models.Update
 		IsBot:        upd.Message.From.IsBot,
 	})
 	if err != nil {
-		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Не удалось зарегистрировать пользователя"})
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "start.register_failed")})
 		return
 	}
 	_ = c.users.TouchInteraction(ctx, userID)
 	if isNew && c.logChatID != 0 {
+		logCtx := i18n.WithLocalizer(ctx, i18n.For(i18n.Default))
 		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{
 			ChatID: c.logChatID,
-			Text:   fmt.Sprintf("Новый пользователь:\n%s", formatBotUser(registered)),
+			Text:   tr(logCtx, "log.new_user", formatBotUser(logCtx, registered)),
 		})
 	}
 
@@ -47,24 +48,24 @@ func (c *Controller) start(ctx context.Context, b *tgbot.Bot, upd *models.Update
 		if err := c.team.Join(ctx, teamID, userID, profile); err != nil {
 			switch {
 			case errors.Is(err, errorz.ErrNotFound):
-				_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Команда не найдена"})
+				_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.not_found")})
 			case errors.Is(err, errorz.ErrAlreadyExists):
-				_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Вы уже в этой команде"})
+				_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.already_in_this")})
 			case errors.Is(err, errorz.ErrConflict):
-				_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Вы уже состоите в другой команде"})
+				_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.in_other")})
 			case errors.Is(err, errorz.ErrLimitExceeded):
-				_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "В команде уже 10 участников"})
+				_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.full")})
 			default:
-				_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Не удалось вступить в команду"})
+				_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.join_failed")})
 			}
 		} else {
-			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Вы вступили в команду"})
+			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.joined")})
 		}
 	}
 
 	_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{
 		ChatID: chatID,
-		Text:   "Добро пожаловать в Громкий вопрос",
+		Text:   tr(ctx, "start.welcome"),
 	})
 	c.sendMenu(ctx, chatID, userID)
 }
@@ -80,8 +81,8 @@ func (c *Controller) menu(ctx context.Context, b *tgbot.Bot, upd *models.Update)
 
 	_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{
 		ChatID:      chatID,
-		Text:        "Главное меню",
-		ReplyMarkup: c.mainMenu(userID),
+		Text:        tr(ctx, "menu.title"),
+		ReplyMarkup: c.mainMenu(ctx, userID),
 	})
 }
 
@@ -122,8 +123,8 @@ func (c *Controller) adminCommand(ctx context.Context, b *tgbot.Bot, upd *models
 	chatID := upd.Message.Chat.ID
 	userID := upd.Message.From.ID
 	_ = c.users.TouchInteraction(ctx, userID)
-	if !c.access.IsAdmin(userID) {
-		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Недостаточно прав"})
+	if !c.access.IsStaff(userID) {
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.forbidden")})
 		return
 	}
 	c.sendAdminMenu(ctx, chatID)
@@ -138,18 +139,25 @@ func (c *Controller) helpCommand(ctx context.Context, b *tgbot.Bot, upd *models.
 	_ = c.users.TouchInteraction(ctx, userID)
 
 	lines := []string{
-		"Доступные команды:",
-		"/play - начать игру",
-		"/team - меню команды",
-		"/profile - ваш профиль",
-		"/menu - главное меню",
-		"/admin - админ-панель",
-		"/help - список команд",
-		"/stop - экстренно остановить текущую форму/пулл",
-		"/jointeam <uuid> - вступить в команду",
+		tr(ctx, "help.title"),
+		tr(ctx, "help.play"),
+		tr(ctx, "help.newgame"),
+		tr(ctx, "help.endgame"),
+		tr(ctx, "help.games"),
+		tr(ctx, "help.team"),
+		tr(ctx, "help.profile"),
+		tr(ctx, "help.suggest"),
+		tr(ctx, "help.menu"),
+		tr(ctx, "help.admin"),
+		tr(ctx, "help.help"),
+		tr(ctx, "help.stop"),
+		tr(ctx, "help.jointeam"),
+	}
+	if c.access.Can(userID, schema.PermissionManageRoles) {
+		lines = append(lines, tr(ctx, "help.roles"), tr(ctx, "help.grant"), tr(ctx, "help.revoke"))
 	}
 	if c.logChatID != 0 && chatID == c.logChatID {
-		lines = append(lines, "/get <id> - информация о пользователе")
+		lines = append(lines, tr(ctx, "help.get"))
 	}
 	_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{
 		ChatID: chatID,
@@ -167,20 +175,20 @@ func (c *Controller) stopCommand(ctx context.Context, b *tgbot.Bot, upd *models.
 
 	state, ok, err := c.form.Get(ctx, userID)
 	if err != nil || !ok {
-		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Нет активной операции"})
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "stop.nothing")})
 		return
 	}
 	added := state.PoolSaved
 	total := len(state.PoolItems)
 	_ = c.form.Cancel(ctx, userID)
-	if state.Step == schema.FormStepPoolInput || state.Step == schema.FormStepPoolPreview || state.Step == schema.FormStepPoolEditQ || state.Step == schema.FormStepPoolEditA {
+	if state.Step == schema.FormStepPoolInput || state.Step == schema.FormStepPoolPreview || state.Step == schema.FormStepPoolEditQ || state.Step == schema.FormStepPoolEditA || state.Step == schema.FormStepImportChoice {
 		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{
 			ChatID: chatID,
-			Text:   fmt.Sprintf("Пулл остановлен. Добавлено: %d из %d", added, total),
+			Text:   tr(ctx, "pool.stopped", added, total),
 		})
 		return
 	}
-	_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Операция остановлена"})
+	_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "stop.done")})
 }
 
 func (c *Controller) joinTeamByCommand(ctx context.Context, b *tgbot.Bot, upd *models.Update) {
@@ -194,37 +202,37 @@ func (c *Controller) joinTeamByCommand(ctx context.Context, b *tgbot.Bot, upd *m
 	if len(args) != 2 {
 		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{
 			ChatID: chatID,
-			Text:   "Использование: /jointeam <uuid>",
+			Text:   tr(ctx, "team.join_usage"),
 		})
 		return
 	}
 
 	teamID := args[1]
 	if !isValidUUID(teamID) {
-		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Неверный формат UUID"})
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.bad_uuid")})
 		return
 	}
 	err := c.team.Join(ctx, teamID, userID, userProfileFromTelegramUser(*upd.Message.From))
 	if err != nil {
 		switch {
 		case errors.Is(err, errorz.ErrNotFound):
-			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Команда не найдена"})
+			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.not_found")})
 		case errors.Is(err, errorz.ErrAlreadyExists):
-			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Вы уже в этой команде"})
+			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.already_in_this")})
 		case errors.Is(err, errorz.ErrConflict):
-			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Вы уже состоите в другой команде"})
+			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.in_other")})
 		case errors.Is(err, errorz.ErrLimitExceeded):
-			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "В команде уже 10 участников"})
+			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.full")})
 		default:
-			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Не удалось вступить в команду"})
+			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.join_failed")})
 		}
 		return
 	}
 
 	_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{
 		ChatID:      chatID,
-		Text:        "Вы вступили в команду",
-		ReplyMarkup: c.mainMenu(userID),
+		Text:        tr(ctx, "team.joined"),
+		ReplyMarkup: c.mainMenu(ctx, userID),
 	})
 }
 
@@ -239,40 +247,39 @@ func (c *Controller) getUserByID(ctx context.Context, b *tgbot.Bot, upd *models.
 	}
 	args := strings.Fields(strings.TrimSpace(upd.Message.Text))
 	if len(args) != 2 {
-		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Использование: /get <id>"})
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "get.usage")})
 		return
 	}
 	id, err := strconv.ParseInt(args[1], 10, 64)
 	if err != nil {
-		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Некорректный id"})
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "get.bad_id")})
 		return
 	}
 	user, ok, err := c.users.GetByID(ctx, id)
 	if err != nil {
-		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Ошибка поиска пользователя"})
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "get.failed")})
 		return
 	}
 	if !ok {
-		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Пользователь не нажимал /start или не найден"})
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "get.not_found")})
 		return
 	}
 	_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{
 		ChatID: chatID,
-		Text:   formatBotUser(user),
+		Text:   formatBotUser(ctx, user),
 	})
 }
 
-func formatBotUser(user schema.BotUser) string {
+func formatBotUser(ctx context.Context, user schema.BotUser) string {
 	name := strings.TrimSpace(strings.TrimSpace(user.FirstName) + " " + strings.TrimSpace(user.LastName))
 	if name == "" {
-		name = "Без имени"
+		name = tr(ctx, "common.no_name")
 	}
-	uname := "нет"
+	uname := tr(ctx, "common.none")
 	if user.Username != "" {
 		uname = "@" + user.Username
 	}
-	return fmt.Sprintf(
-		"id: %d\nимя: %s\nusername: %s\nязык: %s\nis_bot: %t\nпоследнее взаимодействие: %s",
+	return tr(ctx, "get.info",
 		user.UserID,
 		name,
 		uname,
@@ -0,0 +1,85 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+func (c *Controller) handleDailyCallback(ctx context.Context, chatID, userID int64, messageID int, data string, ack func(string, bool)) {
+	parts := strings.Split(data, ":")
+	if len(parts) < 2 {
+		return
+	}
+	switch parts[1] {
+	case "on", "off":
+		if err := c.users.SetDailyQuestion(ctx, userID, parts[1] == "on"); err != nil {
+			log.Printf("set daily question: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		if parts[1] == "on" {
+			ack(tr(ctx, "daily.enabled")+c.daily.SendTime(), true)
+		} else {
+			ack(tr(ctx, "daily.disabled"), false)
+		}
+		c.sendProfileMenuWithMessage(ctx, chatID, userID, messageID)
+		return
+	}
+	if len(parts) < 4 {
+		ack(tr(ctx, "daily.unavailable"), true)
+		return
+	}
+	day := parts[2]
+	if _, err := time.Parse(schema.DayLayout, day); err != nil {
+		return
+	}
+	lang := schema.Language(parts[3])
+	if lang == schema.LanguageAuto || !lang.Valid() {
+		ack(tr(ctx, "daily.unavailable"), true)
+		return
+	}
+	dq, err := c.daily.Question(ctx, day, lang)
+	if err != nil {
+		if !errors.Is(err, errorz.ErrNotFound) {
+			log.Printf("daily question: %v", err)
+		}
+		ack(tr(ctx, "daily.unavailable"), true)
+		return
+	}
+	text := tr(ctx, "daily.header") + dq.Question.QuestionText + tr(ctx, "daily.answer") + dq.Question.AnswerText
+
+	switch parts[1] {
+	case "ans":
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Text:      text + tr(ctx, "daily.knew_prompt"),
+			ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{{
+				{Text: tr(ctx, "daily.knew_yes"), CallbackData: "day:knew:" + day + ":" + string(lang) + ":y"},
+				{Text: tr(ctx, "daily.knew_no"), CallbackData: "day:knew:" + day + ":" + string(lang) + ":n"},
+			}}},
+		})
+	case "knew":
+		if len(parts) < 5 {
+			return
+		}
+		if err := c.daily.Answer(ctx, day, lang, userID, parts[4] == "y"); err != nil {
+			log.Printf("daily answer: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Text:      text + tr(ctx, "daily.thanks"),
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+const duplicateButtonsPerRow = 5
+
+func similarityPercent(v float64) int {
+	return int(math.Round(v * 100))
+}
+
+func (c *Controller) handleDuplicateCallback(ctx context.Context, chatID, userID int64, messageID int, data string, ack func(string, bool)) {
+	if !c.access.Can(userID, schema.PermissionModerate) {
+		ack(tr(ctx, "common.forbidden"), true)
+		return
+	}
+	parts := strings.Split(data, ":")
+	if len(parts) < 3 {
+		return
+	}
+	switch parts[1] {
+	case "list":
+		page, ok := parseIntPart(data, 2)
+		if !ok {
+			return
+		}
+		c.sendDuplicateClustersWithMessage(ctx, chatID, page, messageID)
+	case "q":
+		if !isValidUUID(parts[2]) {
+			return
+		}
+		page := 0
+		if len(parts) > 3 {
+			page, _ = parseIntPart(data, 3)
+		}
+		q, err := c.admin.GetQuestion(ctx, parts[2])
+		if err != nil {
+			if !errors.Is(err, errorz.ErrNotFound) {
+				log.Printf("get similar question: %v", err)
+			}
+			ack(tr(ctx, "question.unavailable"), true)
+			return
+		}
+		c.sendSimilarQuestionCard(ctx, chatID, userID, q, page, messageID)
+	}
+}
+
+func (c *Controller) duplicateWarning(ctx context.Context, matches []schema.SimilarQuestion) string {
+	var b strings.Builder
+	b.WriteString(tr(ctx, "duplicate.warning"))
+	for i, m := range matches {
+		b.WriteString(tr(ctx, "duplicate.match", i+1, shortText(m.QuestionText, 120), questionStatusTitle(ctx, m.Status), similarityPercent(m.Similarity)))
+	}
+	return b.String()
+}
+
+func duplicateButtons(matches []schema.SimilarQuestion) [][]models.InlineKeyboardButton {
+	row := make([]models.InlineKeyboardButton, 0, len(matches))
+	for i, m := range matches {
+		row = append(row, models.InlineKeyboardButton{Text: fmt.Sprintf("🔎 %d", i+1), CallbackData: "dup:q:" + m.ID})
+	}
+	if len(row) == 0 {
+		return nil
+	}
+	return [][]models.InlineKeyboardButton{row}
+}
+
+func (c *Controller) sendDuplicateWarning(ctx context.Context, chatID int64, matches []schema.SimilarQuestion) {
+	rows := duplicateButtons(matches)
+	rows = append(rows,
+		[]models.InlineKeyboardButton{{Text: tr(ctx, "duplicate.save_anyway"), CallbackData: "frm:c:f"}},
+		[]models.InlineKeyboardButton{{Text: tr(ctx, "common.cancel"), CallbackData: "frm:x"}},
+	)
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        c.duplicateWarning(ctx, matches) + tr(ctx, "duplicate.save_prompt"),
+		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: rows},
+	})
+}
+
+func (c *Controller) sendSimilarQuestionCard(ctx context.Context, chatID, userID int64, q schema.Question, page int, messageID int) {
+	text := tr(ctx, "duplicate.card",
+		q.QuestionText,
+		q.AnswerText,
+		c.displayName(ctx, q.AuthorID),
+		questionStatusTitle(ctx, q.Status),
+		categoryTitle(ctx, q.Category),
+		difficultyTitle(ctx, q.Difficulty),
+		languageTitle(ctx, q.Language),
+		q.CreatedAt.Format(tr(ctx, "layout.datetime")),
+	)
+	var rows [][]models.InlineKeyboardButton
+	if q.AuthorID == userID && q.Status == schema.QuestionStatusActive {
+		rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "duplicate.open_own"), CallbackData: fmt.Sprintf("adm:open:%s:1", q.ID)}})
+	}
+	if page > 0 {
+		rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back"), CallbackData: fmt.Sprintf("dup:list:%d", page)}})
+	}
+	var markup models.ReplyMarkup
+	if len(rows) > 0 {
+		markup = &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	}
+	if page > 0 && messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func (c *Controller) sendDuplicateClustersWithMessage(ctx context.Context, chatID int64, page int, messageID int) {
+	clusters, err := c.admin.DuplicateClusters(ctx)
+	if err != nil {
+		log.Printf("duplicate clusters: %v", err)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.error")})
+		return
+	}
+
+	var text string
+	var rows [][]models.InlineKeyboardButton
+	if len(clusters) == 0 {
+		text = tr(ctx, "duplicate.none")
+	} else {
+		page = min(max(page, 1), len(clusters))
+		cluster := clusters[page-1]
+		lines := []string{tr(ctx, "duplicate.cluster", page, len(clusters), similarityPercent(cluster.Similarity))}
+		var buttons []models.InlineKeyboardButton
+		for i, q := range cluster.Questions {
+			lines = append(lines, tr(ctx, "duplicate.cluster_item",
+				i+1,
+				shortText(q.QuestionText, 120),
+				shortText(q.AnswerText, 40),
+				c.displayName(ctx, q.AuthorID),
+				questionStatusTitle(ctx, q.Status),
+			))
+			buttons = append(buttons, models.InlineKeyboardButton{Text: fmt.Sprintf("🔎 %d", i+1), CallbackData: fmt.Sprintf("dup:q:%s:%d", q.ID, page)})
+			if len(buttons) == duplicateButtonsPerRow {
+				rows = append(rows, buttons)
+				buttons = nil
+			}
+		}
+		if len(buttons) > 0 {
+			rows = append(rows, buttons)
+		}
+		text = strings.Join(lines, "\n\n")
+
+		var nav []models.InlineKeyboardButton
+		if page > 1 {
+			nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.prev"), CallbackData: fmt.Sprintf("dup:list:%d", page-1)})
+		}
+		if page < len(clusters) {
+			nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.next"), CallbackData: fmt.Sprintf("dup:list:%d", page+1)})
+		}
+		if len(nav) > 0 {
+			rows = append(rows, nav)
+		}
+	}
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back"), CallbackData: "adm:menu"}})
+
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
@@ -0,0 +1,136 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/domain/schema"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+type exportRow struct {
+	ID         string `json:"id"`
+	Question   string `json:"question"`
+	Answer     string `json:"answer"`
+	Category   string `json:"category"`
+	Difficulty string `json:"difficulty"`
+	Language   string `json:"language"`
+	Status     string `json:"status"`
+	Likes      int    `json:"likes"`
+	Dislikes   int    `json:"dislikes"`
+	Plays      int    `json:"plays"`
+	Guessed    int    `json:"guessed"`
+	Reports    int    `json:"reports"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+var exportHeader = []string{"id", "question", "answer", "category", "difficulty", "language", "status", "likes", "dislikes", "plays", "guessed", "reports", "created_at", "updated_at"}
+
+func (c *Controller) handleExportCallback(ctx context.Context, chatID, userID int64, data string, ack func(string, bool)) {
+	if !c.access.Can(userID, schema.PermissionAuthor) {
+		return
+	}
+	format, ok := parseStringPart(data, 2)
+	if !ok {
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+			ChatID: chatID,
+			Text:   tr(ctx, "export.prompt"),
+			ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+				{{Text: "CSV", CallbackData: "adm:exp:csv"}, {Text: "JSON", CallbackData: "adm:exp:json"}},
+				{{Text: tr(ctx, "common.back"), CallbackData: "adm:list:1"}},
+			}},
+		})
+		return
+	}
+	if format != "csv" && format != "json" {
+		return
+	}
+
+	items, err := c.admin.ExportQuestions(ctx, userID)
+	if err != nil {
+		log.Printf("export questions: %v", err)
+		ack(tr(ctx, "export.failed"), true)
+		return
+	}
+	if len(items) == 0 {
+		ack(tr(ctx, "questions.empty"), true)
+		return
+	}
+	rows := make([]exportRow, 0, len(items))
+	for _, item := range items {
+		rows = append(rows, newExportRow(item))
+	}
+	var content []byte
+	if format == "csv" {
+		content, err = exportCSV(rows)
+	} else {
+		content, err = json.MarshalIndent(rows, "", "  ")
+	}
+	if err != nil {
+		log.Printf("encode export: %v", err)
+		ack(tr(ctx, "export.failed"), true)
+		return
+	}
+
+	ack(tr(ctx, "export.preparing"), false)
+	_, err = c.bot.SendDocument(ctx, &tgbot.SendDocumentParams{
+		ChatID:   chatID,
+		Document: &models.InputFileUpload{Filename: fmt.Sprintf("questions-%s.%s", time.Now().Format("2006-01-02"), format), Data: bytes.NewReader(content)},
+		Caption:  tr(ctx, "export.caption", trn(ctx, "plural.questions", len(rows))),
+	})
+	if err != nil {
+		log.Printf("send export: %v", err)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "export.failed")})
+	}
+}
+
+func newExportRow(item schema.QuestionExport) exportRow {
+	return exportRow{
+		ID:         item.ID,
+		Question:   item.QuestionText,
+		Answer:     item.AnswerText,
+		Category:   string(item.Category),
+		Difficulty: string(item.Difficulty),
+		Language:   string(item.Language),
+		Status:     string(item.Status),
+		Likes:      item.Likes,
+		Dislikes:   item.Dislikes,
+		Plays:      item.Plays,
+		Guessed:    item.Guessed,
+		Reports:    item.Reports,
+		CreatedAt:  item.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:  item.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func exportCSV(rows []exportRow) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("\xef\xbb\xbf")
+	w := csv.NewWriter(&buf)
+	if err := w.Write(exportHeader); err != nil {
+		return nil, err
+	}
+	for _, r := range rows {
+		record := []string{
+			r.ID, r.Question, r.Answer, r.Category, r.Difficulty, r.Language, r.Status,
+			strconv.Itoa(r.Likes), strconv.Itoa(r.Dislikes), strconv.Itoa(r.Plays), strconv.Itoa(r.Guessed), strconv.Itoa(r.Reports),
+			r.CreatedAt, r.UpdatedAt,
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
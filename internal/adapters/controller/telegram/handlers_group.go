@@ -0,0 +1,395 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/schema"
+	gamesvc "LoudQuestionBot/internal/domain/service/game"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+func isGroupChat(chat models.Chat) bool {
+	return chat.Type == models.ChatTypeGroup || chat.Type == models.ChatTypeSupergroup
+}
+
+func isGroupMessage(upd *models.Update) bool {
+	return upd.Message != nil && isGroupChat(upd.Message.Chat)
+}
+
+func (c *Controller) groupCommand(name string) tgbot.MatchFunc {
+	return func(upd *models.Update) bool {
+		if !isGroupMessage(upd) {
+			return false
+		}
+		fields := strings.Fields(upd.Message.Text)
+		if len(fields) == 0 {
+			return false
+		}
+		cmd := fields[0]
+		return cmd == "/"+name || strings.EqualFold(cmd, "/"+name+"@"+c.botUsername)
+	}
+}
+
+func privateCommand(name string) tgbot.MatchFunc {
+	return func(upd *models.Update) bool {
+		if upd.Message == nil || isGroupChat(upd.Message.Chat) {
+			return false
+		}
+		fields := strings.Fields(upd.Message.Text)
+		return len(fields) > 0 && fields[0] == "/"+name
+	}
+}
+
+func (c *Controller) isChatAdmin(ctx context.Context, chatID, userID int64) bool {
+	member, err := c.bot.GetChatMember(ctx, &tgbot.GetChatMemberParams{ChatID: chatID, UserID: userID})
+	if err != nil {
+		log.Printf("get chat member: %v", err)
+		return false
+	}
+	return member.Type == models.ChatMemberTypeOwner || member.Type == models.ChatMemberTypeAdministrator
+}
+
+func (c *Controller) ignoreUpdate(ctx context.Context, b *tgbot.Bot, upd *models.Update) {}
+
+func (c *Controller) groupHostCommand(ctx context.Context, b *tgbot.Bot, upd *models.Update) {
+	if upd.Message.From == nil {
+		return
+	}
+	chatID := upd.Message.Chat.ID
+	userID := upd.Message.From.ID
+	err := c.group.BecomeHost(ctx, chatID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorz.ErrAlreadyExists):
+			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "group.already_host")})
+		case errors.Is(err, errorz.ErrConflict):
+			hostID, _, _ := c.group.Host(ctx, chatID)
+			if c.isChatAdmin(ctx, chatID, userID) {
+				if err := c.group.TakeHost(ctx, chatID, userID); err != nil {
+					log.Printf("group take host: %v", err)
+					_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "group.host_failed")})
+					return
+				}
+				_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{
+					ChatID: chatID,
+					Text:   tr(ctx, "group.host_taken_over", telegramUserName(*upd.Message.From), c.displayName(ctx, hostID)),
+				})
+				return
+			}
+			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{
+				ChatID: chatID,
+				Text:   tr(ctx, "group.host_taken", c.displayName(ctx, hostID)),
+			})
+		default:
+			log.Printf("group become host: %v", err)
+			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "group.host_failed")})
+		}
+		return
+	}
+	_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID: chatID,
+		Text:   tr(ctx, "group.host_set", telegramUserName(*upd.Message.From)),
+	})
+}
+
+func (c *Controller) groupPassHostCommand(ctx context.Context, b *tgbot.Bot, upd *models.Update) {
+	if upd.Message.From == nil {
+		return
+	}
+	chatID := upd.Message.Chat.ID
+	reply := upd.Message.ReplyToMessage
+	if reply == nil || reply.From == nil || reply.From.IsBot {
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "group.passhost_usage")})
+		return
+	}
+	err := c.group.PassHost(ctx, chatID, upd.Message.From.ID, reply.From.ID)
+	if err != nil {
+		if errors.Is(err, errorz.ErrForbidden) {
+			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "group.passhost_host_only")})
+			return
+		}
+		log.Printf("group pass host: %v", err)
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "group.passhost_failed")})
+		return
+	}
+	_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID: chatID,
+		Text:   tr(ctx, "group.new_host") + telegramUserName(*reply.From),
+	})
+}
+
+func (c *Controller) groupUnhostCommand(ctx context.Context, b *tgbot.Bot, upd *models.Update) {
+	if upd.Message.From == nil {
+		return
+	}
+	chatID := upd.Message.Chat.ID
+	err := c.group.ReleaseHost(ctx, chatID, upd.Message.From.ID)
+	if errors.Is(err, errorz.ErrForbidden) && c.isChatAdmin(ctx, chatID, upd.Message.From.ID) {
+		err = c.group.ClearHost(ctx, chatID)
+	}
+	if err != nil {
+		if errors.Is(err, errorz.ErrForbidden) {
+			_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "group.unhost_host_only")})
+			return
+		}
+		log.Printf("group release host: %v", err)
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "group.unhost_failed")})
+		return
+	}
+	_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "group.unhosted")})
+}
+
+func (c *Controller) groupPlayCommand(ctx context.Context, b *tgbot.Bot, upd *models.Update) {
+	if upd.Message.From == nil {
+		return
+	}
+	chatID := upd.Message.Chat.ID
+	userID := upd.Message.From.ID
+	_ = c.users.TouchInteraction(ctx, userID)
+	hostID, ok, err := c.group.Host(ctx, chatID)
+	if err != nil {
+		log.Printf("group host: %v", err)
+		return
+	}
+	if !ok {
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "group.need_host")})
+		return
+	}
+	if hostID != userID {
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "group.ask_host_only")})
+		return
+	}
+	if err := c.postGroupQuestion(ctx, chatID, hostID); err != nil {
+		if errors.Is(err, gamesvc.ErrNoNewQuestions) {
+			c.sendGroupNoNew(ctx, chatID)
+			return
+		}
+		log.Printf("group next question: %v", err)
+	}
+}
+
+func (c *Controller) groupNewGameCommand(ctx context.Context, b *tgbot.Bot, upd *models.Update) {
+	if upd.Message.From == nil {
+		return
+	}
+	chatID := upd.Message.Chat.ID
+	isHost, err := c.group.IsHost(ctx, chatID, upd.Message.From.ID)
+	if err != nil {
+		log.Printf("group is host: %v", err)
+		return
+	}
+	if !isHost {
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "group.newgame_host_only")})
+		return
+	}
+	c.startGame(ctx, chatID, upd.Message.From.ID, upd.Message.Text)
+}
+
+func (c *Controller) groupEndGameCommand(ctx context.Context, b *tgbot.Bot, upd *models.Update) {
+	if upd.Message.From == nil {
+		return
+	}
+	chatID := upd.Message.Chat.ID
+	isHost, err := c.group.IsHost(ctx, chatID, upd.Message.From.ID)
+	if err != nil {
+		log.Printf("group is host: %v", err)
+		return
+	}
+	if !isHost {
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "group.endgame_host_only")})
+		return
+	}
+	c.endGame(ctx, chatID)
+}
+
+func (c *Controller) groupHelpCommand(ctx context.Context, b *tgbot.Bot, upd *models.Update) {
+	lines := []string{
+		tr(ctx, "group.help_title"),
+		tr(ctx, "group.help_host"),
+		tr(ctx, "group.help_passhost"),
+		tr(ctx, "group.help_unhost"),
+		tr(ctx, "group.help_play"),
+		tr(ctx, "group.help_newgame"),
+		tr(ctx, "group.help_endgame"),
+		tr(ctx, "group.help_games"),
+		tr(ctx, "help.help"),
+		"",
+		tr(ctx, "group.help_note"),
+	}
+	_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID: upd.Message.Chat.ID,
+		Text:   strings.Join(lines, "\n"),
+	})
+}
+
+func (c *Controller) handleGroupCallback(ctx context.Context, cb *models.CallbackQuery, ack func(string, bool)) {
+	if cb.Message.Message == nil {
+		return
+	}
+	chatID := cb.Message.Message.Chat.ID
+	messageID := cb.Message.Message.ID
+	userID := cb.From.ID
+	data := cb.Data
+
+	isHost, err := c.group.IsHost(ctx, chatID, userID)
+	if err != nil {
+		log.Printf("group is host: %v", err)
+		ack(tr(ctx, "common.error"), true)
+		return
+	}
+	if !isHost {
+		if strings.HasPrefix(data, "g:ans:") {
+			ack(tr(ctx, "group.answer_host_only"), true)
+			return
+		}
+		ack(tr(ctx, "group.host_only"), true)
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(data, "g:ans:"):
+		id, ok := parseStringPart(data, 2)
+		if !ok || !isValidUUID(id) {
+			return
+		}
+		answer, err := c.game.AnswerByQuestionID(ctx, id)
+		if err != nil {
+			if errors.Is(err, errorz.ErrNotFound) {
+				ack(tr(ctx, "question.unavailable"), true)
+				return
+			}
+			log.Printf("group answer by id: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		if err := c.game.MarkAnsweredByUser(ctx, userID, id); err != nil {
+			log.Printf("mark answered by user: %v", err)
+		}
+		ack(tr(ctx, "label.answer")+answer, true)
+	case strings.HasPrefix(data, "g:rev:"):
+		id, ok := parseStringPart(data, 2)
+		if !ok || !isValidUUID(id) {
+			return
+		}
+		q, err := c.game.ActiveQuestionByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, errorz.ErrNotFound) {
+				ack(tr(ctx, "question.unavailable"), true)
+				return
+			}
+			log.Printf("group reveal: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		if err := c.session.RecordReveal(ctx, chatID, q.ID); err != nil {
+			log.Printf("record session reveal: %v", err)
+		}
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Text:      tr(ctx, "group.revealed", q.QuestionText, q.AnswerText),
+			ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+				ratingButtons(q.ID),
+				{{Text: tr(ctx, "group.next"), CallbackData: "g:next"}},
+				{{Text: tr(ctx, "game.end_button"), CallbackData: "g:end"}},
+			}},
+		})
+	case data == "g:end":
+		c.endGame(ctx, chatID)
+	case data == "g:next":
+		if err := c.postGroupQuestion(ctx, chatID, userID); err != nil {
+			if errors.Is(err, gamesvc.ErrNoNewQuestions) {
+				c.sendGroupNoNew(ctx, chatID)
+				return
+			}
+			log.Printf("group next question: %v", err)
+			ack(tr(ctx, "common.error"), true)
+		}
+	case data == "g:reset":
+		if _, err := c.game.ResetProgress(ctx, schema.PlayScope{ChatID: chatID}); err != nil {
+			log.Printf("group reset progress: %v", err)
+			ack(tr(ctx, "play.reset_failed"), true)
+			return
+		}
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Text:      tr(ctx, "group.reset_done"),
+		})
+		if err := c.postGroupQuestion(ctx, chatID, userID); err != nil {
+			if errors.Is(err, gamesvc.ErrNoNewQuestions) {
+				ack(tr(ctx, "game.no_new"), true)
+				return
+			}
+			log.Printf("group next question: %v", err)
+			ack(tr(ctx, "common.error"), true)
+		}
+	}
+}
+
+func (c *Controller) sendGroupNoNew(ctx context.Context, chatID int64) {
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID: chatID,
+		Text:   tr(ctx, "game.no_new") + tr(ctx, "group.reset_note"),
+		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+			{{Text: tr(ctx, "play.reset_button"), CallbackData: "g:reset"}},
+		}},
+	})
+}
+
+func (c *Controller) postGroupQuestion(ctx context.Context, chatID, hostID int64) error {
+	if c.postSummaryIfLimitReached(ctx, chatID) {
+		return nil
+	}
+	teamID := ""
+	if t, ok, err := c.team.GetByUserID(ctx, hostID); err == nil && ok {
+		teamID = t.ID
+	}
+	q, err := c.game.NextChatQuestion(ctx, chatID, hostID, teamID, ctxLanguage(ctx))
+	if err != nil {
+		return err
+	}
+	if err := c.session.RecordDraw(ctx, chatID, hostID, "", q.ID); err != nil {
+		log.Printf("record session draw: %v", err)
+	}
+	_, err = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        tr(ctx, "group.question", q.QuestionText, c.displayName(ctx, hostID)),
+		ReplyMarkup: groupQuestionMarkup(ctx, q),
+	})
+	return err
+}
+
+func groupQuestionMarkup(ctx context.Context, q schema.Question) *models.InlineKeyboardMarkup {
+	return &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+		{{Text: tr(ctx, "group.peek"), CallbackData: "g:ans:" + q.ID}},
+		{{Text: tr(ctx, "group.reveal"), CallbackData: "g:rev:" + q.ID}},
+		{{Text: tr(ctx, "group.next"), CallbackData: "g:next"}},
+		{{Text: tr(ctx, "game.end_button"), CallbackData: "g:end"}},
+	}}
+}
+
+func (c *Controller) displayName(ctx context.Context, userID int64) string {
+	user, ok, err := c.users.GetByID(ctx, userID)
+	if err != nil || !ok {
+		return fmt.Sprintf("id=%d", userID)
+	}
+	return telegramUserName(models.User{ID: user.UserID, FirstName: user.FirstName, LastName: user.LastName, Username: user.Username})
+}
+
+func telegramUserName(user models.User) string {
+	name := strings.TrimSpace(strings.TrimSpace(user.FirstName) + " " + strings.TrimSpace(user.LastName))
+	if name == "" && user.Username != "" {
+		return "@" + user.Username
+	}
+	if name == "" {
+		return fmt.Sprintf("id=%d", user.ID)
+	}
+	return name
+}
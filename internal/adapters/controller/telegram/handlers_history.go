@@ -0,0 +1,203 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+const revisionsPerPage = 3
+
+func (c *Controller) handleHistoryCallback(ctx context.Context, chatID, userID int64, messageID int, data string, ack func(string, bool)) {
+	if !c.access.Can(userID, schema.PermissionAuthor) {
+		return
+	}
+	parts := strings.Split(data, ":")
+	if len(parts) < 5 || !isValidUUID(parts[2]) {
+		return
+	}
+	qid := parts[2]
+	switch parts[1] {
+	case "hist":
+		page, err := strconv.Atoi(parts[3])
+		if err != nil {
+			return
+		}
+		histPage, err := strconv.Atoi(parts[4])
+		if err != nil {
+			return
+		}
+		c.sendHistoryWithMessage(ctx, chatID, userID, qid, page, histPage, messageID)
+	case "rv":
+		revisionID, err := strconv.ParseInt(parts[3], 10, 64)
+		if err != nil {
+			return
+		}
+		page, err := strconv.Atoi(parts[4])
+		if err != nil {
+			return
+		}
+		rev, err := c.admin.RestoreRevision(ctx, userID, qid, revisionID)
+		if err != nil {
+			if errors.Is(err, errorz.ErrNotFound) || errors.Is(err, errorz.ErrForbidden) {
+				ack(tr(ctx, "question.unavailable"), true)
+				return
+			}
+			log.Printf("restore revision: %v", err)
+			ack(tr(ctx, "history.restore_failed"), true)
+			return
+		}
+		ack(tr(ctx, "history.restored", rev.Number), false)
+		c.sendHistoryWithMessage(ctx, chatID, userID, qid, page, 1, messageID)
+	}
+}
+
+func (c *Controller) sendHistoryWithMessage(ctx context.Context, chatID, userID int64, questionID string, page, histPage int, messageID int) {
+	revisions, err := c.admin.Revisions(ctx, userID, questionID)
+	if err != nil {
+		if !errors.Is(err, errorz.ErrNotFound) && !errors.Is(err, errorz.ErrForbidden) {
+			log.Printf("question revisions: %v", err)
+		}
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "question.unavailable")})
+		return
+	}
+
+	totalPages := (len(revisions) + revisionsPerPage - 1) / revisionsPerPage
+	histPage = min(max(histPage, 1), totalPages)
+	newest := len(revisions) - 1 - (histPage-1)*revisionsPerPage
+	oldest := max(newest-revisionsPerPage+1, 0)
+
+	blocks := []string{tr(ctx, "history.title", len(revisions))}
+	var restore []models.InlineKeyboardButton
+	for i := newest; i >= oldest; i-- {
+		rev := revisions[i]
+		header := tr(ctx, "history.revision", rev.Number, rev.CreatedAt.Format(tr(ctx, "layout.datetime")), c.displayName(ctx, rev.EditedBy))
+		if i == len(revisions)-1 {
+			header += tr(ctx, "history.current")
+		} else if rev.ID > 0 {
+			restore = append(restore, models.InlineKeyboardButton{
+				Text:         tr(ctx, "history.restore_button", rev.Number),
+				CallbackData: fmt.Sprintf("adm:rv:%s:%d:%d", questionID, rev.ID, page),
+			})
+		}
+		if i == 0 {
+			blocks = append(blocks, header+tr(ctx, "history.original", rev.QuestionText, rev.AnswerText))
+			continue
+		}
+		blocks = append(blocks, header+revisionChanges(ctx, revisions[i-1], rev))
+	}
+
+	var rows [][]models.InlineKeyboardButton
+	for start := 0; start < len(restore); start += 3 {
+		rows = append(rows, restore[start:min(start+3, len(restore))])
+	}
+	if totalPages > 1 {
+		nav := []models.InlineKeyboardButton{}
+		if histPage > 1 {
+			nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.prev"), CallbackData: fmt.Sprintf("adm:hist:%s:%d:%d", questionID, page, histPage-1)})
+		}
+		nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.page", histPage, totalPages), CallbackData: "noop"})
+		if histPage < totalPages {
+			nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.next"), CallbackData: fmt.Sprintf("adm:hist:%s:%d:%d", questionID, page, histPage+1)})
+		}
+		rows = append(rows, nav)
+	}
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "history.back_to_question"), CallbackData: fmt.Sprintf("adm:open:%s:%d", questionID, page)}})
+
+	text := strings.Join(blocks, "\n\n")
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func revisionChanges(ctx context.Context, prev, rev schema.QuestionRevision) string {
+	var lines []string
+	if prev.QuestionText != rev.QuestionText {
+		lines = append(lines, tr(ctx, "history.field_question", wordDiff(prev.QuestionText, rev.QuestionText)))
+	}
+	if prev.AnswerText != rev.AnswerText {
+		lines = append(lines, tr(ctx, "history.field_answer", wordDiff(prev.AnswerText, rev.AnswerText)))
+	}
+	if prev.Category != rev.Category {
+		lines = append(lines, tr(ctx, "history.field_category", categoryTitle(ctx, prev.Category), categoryTitle(ctx, rev.Category)))
+	}
+	if prev.Difficulty != rev.Difficulty {
+		lines = append(lines, tr(ctx, "history.field_difficulty", difficultyTitle(ctx, prev.Difficulty), difficultyTitle(ctx, rev.Difficulty)))
+	}
+	if prev.Language != rev.Language {
+		lines = append(lines, tr(ctx, "history.field_language", languageTitle(ctx, prev.Language), languageTitle(ctx, rev.Language)))
+	}
+	if len(lines) == 0 {
+		return "\n" + tr(ctx, "history.no_changes")
+	}
+	return "\n" + strings.Join(lines, "\n")
+}
+
+func wordDiff(before, after string) string {
+	a := strings.Fields(before)
+	b := strings.Fields(after)
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out []string
+	var removed, added []string
+	flush := func() {
+		if len(removed) > 0 {
+			out = append(out, "[-"+strings.Join(removed, " ")+"-]")
+			removed = nil
+		}
+		if len(added) > 0 {
+			out = append(out, "{+"+strings.Join(added, " ")+"+}")
+			added = nil
+		}
+	}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			flush()
+			out = append(out, a[i])
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			added = append(added, b[j])
+			j++
+		default:
+			removed = append(removed, a[i])
+			i++
+		}
+	}
+	flush()
+	return strings.Join(out, " ")
+}
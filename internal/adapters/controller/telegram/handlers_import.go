@@ -0,0 +1,454 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/adapters/controller/telegram/i18n"
+	"LoudQuestionBot/internal/domain/schema"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path"
+	"strings"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+const (
+	maxImportFileSize     = 5 << 20
+	maxImportQuestions    = 5000
+	importErrorsShown     = 20
+	importDuplicatesShown = 10
+)
+
+type importRow struct {
+	Question   string `json:"question"`
+	Answer     string `json:"answer"`
+	Category   string `json:"category"`
+	Difficulty string `json:"difficulty"`
+	Language   string `json:"language"`
+}
+
+type importReport struct {
+	Items      []schema.QuestionDraft
+	Errors     []string
+	Duplicates map[int]schema.SimilarQuestion
+}
+
+var importColumns = map[string][]string{
+	"question":   {"question", "вопрос"},
+	"answer":     {"answer", "ответ"},
+	"category":   {"category", "категория"},
+	"difficulty": {"difficulty", "сложность"},
+	"language":   {"language", "lang", "язык"},
+}
+
+var importPositions = []string{"question", "answer", "category", "difficulty", "language"}
+
+func (c *Controller) handleDocument(ctx context.Context, upd *models.Update) {
+	msg := upd.Message
+	if msg == nil || msg.From == nil || msg.Document == nil || msg.Chat.Type != models.ChatTypePrivate {
+		return
+	}
+	userID := msg.From.ID
+	chatID := msg.Chat.ID
+
+	state, ok, err := c.form.Get(ctx, userID)
+	if err != nil {
+		log.Printf("load form state: %v", err)
+		return
+	}
+	if !ok || state.Step != schema.FormStepPoolInput || !c.access.Can(userID, schema.PermissionAuthor) {
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.use_menu")})
+		return
+	}
+	if msg.Document.FileSize > maxImportFileSize {
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "import.too_large", maxImportFileSize>>20)})
+		return
+	}
+	format := importFormat(msg.Document.FileName, msg.Document.MimeType)
+	if format == "" {
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "import.unsupported")})
+		return
+	}
+	data, err := c.downloadDocument(ctx, msg.Document.FileID)
+	if err != nil {
+		if errors.Is(err, errImportTooLarge) {
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "import.too_large", maxImportFileSize>>20)})
+			return
+		}
+		log.Printf("download import document: %v", err)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "import.download_failed")})
+		return
+	}
+
+	report, err := parseImport(ctx, format, data)
+	if err != nil {
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "pool.parse_error") + err.Error()})
+		return
+	}
+	if len(report.Items) > maxImportQuestions {
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "import.too_many", i18n.Count(maxImportQuestions))})
+		return
+	}
+	for i := range report.Items {
+		if report.Items[i].Language == schema.LanguageAuto {
+			report.Items[i].Language = state.Draft.Language
+		}
+		report.Items[i].PackID = state.Draft.PackID
+	}
+	if len(report.Items) > 0 {
+		report.Duplicates, err = c.admin.FindDuplicates(ctx, report.Items)
+		if err != nil {
+			log.Printf("find import duplicates: %v", err)
+		}
+	}
+
+	if len(report.Items) > 0 {
+		state.Step = schema.FormStepImportChoice
+		state.PoolItems = report.Items
+		state.PoolIndex = 0
+		state.PoolSaved = 0
+		state.PoolDupes = report.duplicateIndexes()
+		_ = c.form.Save(ctx, userID, state)
+	}
+	c.sendImportReport(ctx, chatID, msg.Document.FileName, report)
+}
+
+func (r importReport) duplicateIndexes() []int {
+	out := make([]int, 0, len(r.Duplicates))
+	for i := range r.Items {
+		if _, ok := r.Duplicates[i]; ok {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+var errImportTooLarge = errors.New("import document too large")
+
+func (c *Controller) downloadDocument(ctx context.Context, fileID string) ([]byte, error) {
+	file, err := c.bot.GetFile(ctx, &tgbot.GetFileParams{FileID: fileID})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.bot.FileDownloadLink(file), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download file: status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxImportFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxImportFileSize {
+		return nil, errImportTooLarge
+	}
+	return data, nil
+}
+
+func (c *Controller) sendImportReport(ctx context.Context, chatID int64, fileName string, report importReport) {
+	lines := []string{tr(ctx, "import.summary", fileName, i18n.Count(len(report.Items)), i18n.Count(len(report.Errors)))}
+	if len(report.Errors) > 0 {
+		lines = append(lines, "")
+		for _, e := range report.Errors[:min(importErrorsShown, len(report.Errors))] {
+			lines = append(lines, "• "+e)
+		}
+		if rest := len(report.Errors) - importErrorsShown; rest > 0 {
+			lines = append(lines, tr(ctx, "import.more_errors", i18n.Count(rest)))
+		}
+	}
+
+	dupes := report.duplicateIndexes()
+	if len(dupes) > 0 {
+		lines = append(lines, "", tr(ctx, "import.duplicates", i18n.Count(len(dupes))))
+		for _, i := range dupes[:min(importDuplicatesShown, len(dupes))] {
+			m := report.Duplicates[i]
+			lines = append(lines, tr(ctx, "import.duplicate_item", shortText(report.Items[i].QuestionText, 60), shortText(m.QuestionText, 60), similarityPercent(m.Similarity)))
+		}
+		if rest := len(dupes) - importDuplicatesShown; rest > 0 {
+			lines = append(lines, tr(ctx, "import.more_duplicates", i18n.Count(rest)))
+		}
+	}
+
+	params := &tgbot.SendMessageParams{ChatID: chatID, Text: strings.Join(lines, "\n")}
+	if len(report.Items) > 0 {
+		params.Text += tr(ctx, "import.choose")
+		rows := [][]models.InlineKeyboardButton{
+			{{Text: tr(ctx, "import.all_button", i18n.Count(len(report.Items))), CallbackData: "frm:imp:all"}},
+		}
+		if fresh := len(report.Items) - len(dupes); len(dupes) > 0 && fresh > 0 {
+			rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "import.new_button", i18n.Count(fresh)), CallbackData: "frm:imp:new"}})
+		}
+		rows = append(rows,
+			[]models.InlineKeyboardButton{{Text: tr(ctx, "import.step_button"), CallbackData: "frm:imp:step"}},
+			[]models.InlineKeyboardButton{{Text: tr(ctx, "common.cancel"), CallbackData: "frm:x"}},
+		)
+		params.ReplyMarkup = &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	} else {
+		params.Text += tr(ctx, "import.nothing")
+	}
+	_, _ = c.bot.SendMessage(ctx, params)
+
+	if len(report.Errors) > importErrorsShown {
+		_, _ = c.bot.SendDocument(ctx, &tgbot.SendDocumentParams{
+			ChatID:   chatID,
+			Document: &models.InputFileUpload{Filename: "import-errors.txt", Data: strings.NewReader(strings.Join(report.Errors, "\n"))},
+			Caption:  tr(ctx, "import.errors_file"),
+		})
+	}
+}
+
+func (c *Controller) handleImportCallback(ctx context.Context, chatID, userID int64, data string, ack func(string, bool)) {
+	if !c.access.Can(userID, schema.PermissionAuthor) {
+		return
+	}
+	state, ok, err := c.form.Get(ctx, userID)
+	if err != nil || !ok || state.Step != schema.FormStepImportChoice {
+		ack(tr(ctx, "common.form_expired"), true)
+		return
+	}
+	switch data {
+	case "frm:imp:all", "frm:imp:new":
+		items := state.PoolItems
+		if data == "frm:imp:new" {
+			items = withoutIndexes(state.PoolItems, state.PoolDupes)
+		}
+		created, err := c.admin.ImportQuestions(ctx, userID, items)
+		if err != nil {
+			log.Printf("import questions: %v", err)
+			ack(tr(ctx, "import.failed"), true)
+			return
+		}
+		_ = c.form.Cancel(ctx, userID)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "import.done", i18n.Count(created))})
+		c.sendAdminMenu(ctx, chatID)
+	case "frm:imp:step":
+		state.Step = schema.FormStepPoolPreview
+		state.PoolIndex = 0
+		_ = c.form.Save(ctx, userID, state)
+		c.sendPoolPreview(ctx, chatID, state)
+	}
+}
+
+func withoutIndexes(items []schema.QuestionDraft, skip []int) []schema.QuestionDraft {
+	skipped := make(map[int]bool, len(skip))
+	for _, i := range skip {
+		skipped[i] = true
+	}
+	out := make([]schema.QuestionDraft, 0, len(items))
+	for i, item := range items {
+		if !skipped[i] {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+func importFormat(fileName, mimeType string) string {
+	switch strings.ToLower(path.Ext(fileName)) {
+	case ".csv":
+		return "csv"
+	case ".json":
+		return "json"
+	case ".txt":
+		return "txt"
+	}
+	switch {
+	case strings.Contains(mimeType, "csv"):
+		return "csv"
+	case strings.Contains(mimeType, "json"):
+		return "json"
+	case strings.HasPrefix(mimeType, "text/"):
+		return "txt"
+	}
+	return ""
+}
+
+func parseImport(ctx context.Context, format string, data []byte) (importReport, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	switch format {
+	case "csv":
+		return parseImportCSV(ctx, data)
+	case "json":
+		return parseImportJSON(ctx, data)
+	default:
+		return parseImportText(ctx, data), nil
+	}
+}
+
+func parseImportText(ctx context.Context, data []byte) importReport {
+	var report importReport
+	for i, raw := range strings.Split(string(data), "\n") {
+		line := strings.TrimSpace(raw)
+		if line == "" {
+			continue
+		}
+		m := poolLineRx.FindStringSubmatch(line)
+		if len(m) != 5 {
+			report.Errors = append(report.Errors, tr(ctx, "pool.err_format", i+1))
+			continue
+		}
+		report.add(poolDraft(ctx, i+1, m[1], m[2], m[3], m[4]))
+	}
+	return report
+}
+
+func parseImportCSV(ctx context.Context, data []byte) (importReport, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = csvDelimiter(data)
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	r.TrimLeadingSpace = true
+
+	var report importReport
+	columns := map[string]int{}
+	for i, name := range importPositions {
+		columns[name] = i
+	}
+	first := true
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var perr *csv.ParseError
+			if errors.As(err, &perr) {
+				return report, errors.New(tr(ctx, "import.err_csv", perr.Line))
+			}
+			return report, err
+		}
+		line, _ := r.FieldPos(0)
+		if first {
+			first = false
+			if header, ok := csvHeader(record); ok {
+				columns = header
+				continue
+			}
+		}
+		if isBlankRecord(record) {
+			continue
+		}
+		cell := func(name string) string {
+			idx, ok := columns[name]
+			if !ok || idx >= len(record) {
+				return ""
+			}
+			return record[idx]
+		}
+		report.add(importDraft(ctx, line, importRow{
+			Question:   cell("question"),
+			Answer:     cell("answer"),
+			Category:   cell("category"),
+			Difficulty: cell("difficulty"),
+			Language:   cell("language"),
+		}))
+	}
+	return report, nil
+}
+
+func parseImportJSON(ctx context.Context, data []byte) (importReport, error) {
+	var report importReport
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+		return report, errors.New(tr(ctx, "import.err_json_array"))
+	}
+	for dec.More() {
+		line := lineAt(data, int(dec.InputOffset()))
+		var row importRow
+		if err := dec.Decode(&row); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(err, &typeErr) {
+				report.Errors = append(report.Errors, tr(ctx, "import.err_json_row", line))
+				continue
+			}
+			return report, errors.New(tr(ctx, "import.err_json", lineAt(data, int(dec.InputOffset()))))
+		}
+		report.add(importDraft(ctx, line, row))
+	}
+	return report, nil
+}
+
+func importDraft(ctx context.Context, line int, row importRow) (schema.QuestionDraft, error) {
+	draft, err := poolDraft(ctx, line, row.Question, row.Answer, row.Category, row.Difficulty)
+	if err != nil {
+		return schema.QuestionDraft{}, err
+	}
+	if strings.TrimSpace(row.Language) != "" {
+		lang, ok := parseLanguage(row.Language)
+		if !ok {
+			return schema.QuestionDraft{}, errors.New(tr(ctx, "import.err_language", line, shortText(row.Language, 40)))
+		}
+		draft.Language = lang
+	}
+	return draft, nil
+}
+
+func (r *importReport) add(draft schema.QuestionDraft, err error) {
+	if err != nil {
+		r.Errors = append(r.Errors, err.Error())
+		return
+	}
+	r.Items = append(r.Items, draft)
+}
+
+func csvDelimiter(data []byte) rune {
+	head := data
+	if i := bytes.IndexByte(head, '\n'); i >= 0 {
+		head = head[:i]
+	}
+	best, bestCount := ',', bytes.Count(head, []byte{','})
+	for _, d := range []rune{';', '\t'} {
+		if n := bytes.Count(head, []byte(string(d))); n > bestCount {
+			best, bestCount = d, n
+		}
+	}
+	return best
+}
+
+func csvHeader(record []string) (map[string]int, bool) {
+	columns := map[string]int{}
+	for i, raw := range record {
+		v := strings.ToLower(strings.TrimSpace(raw))
+		for name, aliases := range importColumns {
+			for _, alias := range aliases {
+				if v == alias {
+					columns[name] = i
+				}
+			}
+		}
+	}
+	_, hasQuestion := columns["question"]
+	_, hasAnswer := columns["answer"]
+	return columns, hasQuestion && hasAnswer
+}
+
+func isBlankRecord(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func lineAt(data []byte, offset int) int {
+	offset = min(offset, len(data))
+	for offset < len(data) && strings.ContainsRune(" \t\r\n,", rune(data[offset])) {
+		offset++
+	}
+	return bytes.Count(data[:offset], []byte{'\n'}) + 1
+}
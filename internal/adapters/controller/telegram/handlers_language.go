@@ -0,0 +1,80 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/adapters/controller/telegram/i18n"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"log"
+	"strings"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+var languageKeys = map[schema.Language]string{
+	schema.LanguageAuto:    "lang.auto",
+	schema.LanguageRussian: "lang.ru",
+	schema.LanguageEnglish: "lang.en",
+}
+
+func languageTitle(ctx context.Context, lang schema.Language) string {
+	if key, ok := languageKeys[lang]; ok {
+		return tr(ctx, key)
+	}
+	return tr(ctx, languageKeys[schema.LanguageAuto])
+}
+
+func (c *Controller) handleLanguageCallback(ctx context.Context, chatID, userID int64, messageID int, data string, ack func(string, bool)) {
+	parts := strings.Split(data, ":")
+	if len(parts) < 2 {
+		return
+	}
+	switch parts[1] {
+	case "menu":
+		c.sendLanguageMenuWithMessage(ctx, chatID, userID, messageID)
+	case "set":
+		if len(parts) < 3 {
+			return
+		}
+		lang := schema.Language(parts[2])
+		if parts[2] == "auto" {
+			lang = schema.LanguageAuto
+		}
+		if err := c.users.SetLanguage(ctx, userID, lang); err != nil {
+			log.Printf("set language: %v", err)
+			ack(tr(ctx, "lang.failed"), true)
+			return
+		}
+		ctx = i18n.WithLocalizer(ctx, i18n.For(c.userLang(ctx, userID, "")))
+		ack(tr(ctx, "lang.saved"), false)
+		c.sendProfileMenuWithMessage(ctx, chatID, userID, messageID)
+	}
+}
+
+func (c *Controller) sendLanguageMenuWithMessage(ctx context.Context, chatID, userID int64, messageID int) {
+	user, _, err := c.users.GetByID(ctx, userID)
+	if err != nil {
+		log.Printf("language menu get user: %v", err)
+	}
+	text := tr(ctx, "lang.prompt", languageTitle(ctx, user.Language))
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+		{{Text: tr(ctx, "lang.auto_button"), CallbackData: "lang:set:auto"}},
+		{{Text: tr(ctx, "lang.ru_button"), CallbackData: "lang:set:ru"}},
+		{{Text: tr(ctx, "lang.en_button"), CallbackData: "lang:set:en"}},
+		{{Text: tr(ctx, "common.back"), CallbackData: "profile:menu"}},
+	}}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
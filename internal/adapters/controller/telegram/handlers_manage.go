@@ -0,0 +1,436 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+func (c *Controller) handleManageCallback(ctx context.Context, chatID, userID int64, messageID int, data string, ack func(string, bool)) {
+	if !c.access.Can(userID, schema.PermissionEditOthers) {
+		ack(tr(ctx, "common.forbidden"), true)
+		return
+	}
+	parts := strings.Split(data, ":")
+	if len(parts) < 3 {
+		return
+	}
+	switch parts[1] {
+	case "l":
+		if len(parts) < 4 {
+			return
+		}
+		authorID, err := strconv.ParseInt(parts[2], 10, 64)
+		if err != nil {
+			return
+		}
+		page, err := strconv.Atoi(parts[3])
+		if err != nil {
+			return
+		}
+		c.sendManagedQuestionsWithMessage(ctx, chatID, authorID, page, messageID)
+		return
+	case "au":
+		page, ok := parseIntPart(data, 2)
+		if !ok {
+			return
+		}
+		c.sendQuestionAuthorsWithMessage(ctx, chatID, page, messageID)
+		return
+	case "log":
+		page, ok := parseIntPart(data, 2)
+		if !ok {
+			return
+		}
+		c.sendAuditLogWithMessage(ctx, chatID, page, messageID)
+		return
+	}
+
+	if len(parts) < 5 || !isValidUUID(parts[2]) {
+		return
+	}
+	qid := parts[2]
+	authorID, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return
+	}
+	page, err := strconv.Atoi(parts[4])
+	if err != nil {
+		return
+	}
+	back := fmt.Sprintf("%s:%d:%d", qid, authorID, page)
+
+	switch parts[1] {
+	case "o":
+		q, err := c.admin.GetQuestion(ctx, qid)
+		if err != nil || (q.Status != schema.QuestionStatusActive && q.Status != schema.QuestionStatusHidden) {
+			if err != nil && !errors.Is(err, errorz.ErrNotFound) {
+				log.Printf("get managed question: %v", err)
+			}
+			ack(tr(ctx, "question.unavailable"), true)
+			c.sendManagedQuestionsWithMessage(ctx, chatID, authorID, page, messageID)
+			return
+		}
+		c.sendManagedQuestionCardWithMessage(ctx, chatID, q, authorID, page, messageID)
+	case "e":
+		q, err := c.admin.GetQuestion(ctx, qid)
+		if err != nil || (q.Status != schema.QuestionStatusActive && q.Status != schema.QuestionStatusHidden) {
+			ack(tr(ctx, "question.unavailable"), true)
+			return
+		}
+		_ = c.form.StartManageEdit(ctx, userID, q.ID, authorID, page, schema.QuestionDraft{QuestionText: q.QuestionText, AnswerText: q.AnswerText, Category: q.Category, Difficulty: q.Difficulty, Language: q.Language})
+		c.sendChooseField(ctx, chatID)
+	case "d":
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Text:      tr(ctx, "manage.delete_confirm"),
+			ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+				{{Text: tr(ctx, "question.delete_yes"), CallbackData: "sa:dd:" + back}},
+				{{Text: tr(ctx, "question.delete_no"), CallbackData: "sa:o:" + back}},
+			}},
+		})
+	case "dd":
+		if err := c.admin.DeleteAnyQuestion(ctx, userID, qid); err != nil {
+			if errors.Is(err, errorz.ErrNotFound) {
+				ack(tr(ctx, "question.unavailable"), true)
+				return
+			}
+			log.Printf("delete managed question: %v", err)
+			ack(tr(ctx, "question.delete_failed"), true)
+			return
+		}
+		ack(tr(ctx, "manage.deleted"), false)
+		c.sendManagedQuestionsWithMessage(ctx, chatID, authorID, page, messageID)
+	case "r":
+		_ = c.form.StartReassign(ctx, userID, qid, authorID, page)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+			ChatID: chatID,
+			Text:   tr(ctx, "manage.reassign_prompt"),
+			ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+				{{Text: tr(ctx, "common.cancel"), CallbackData: "frm:x"}},
+			}},
+		})
+	case "h":
+		c.sendQuestionAuditWithMessage(ctx, chatID, qid, authorID, page, messageID)
+	}
+}
+
+func (c *Controller) handleReassignText(ctx context.Context, chatID, userID int64, state schema.FormState, text string) {
+	if !c.access.Can(userID, schema.PermissionEditOthers) {
+		return
+	}
+	authorID, err := strconv.ParseInt(strings.TrimPrefix(text, "id="), 10, 64)
+	if err != nil || authorID <= 0 {
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "manage.reassign_prompt")})
+		return
+	}
+	q, err := c.admin.ReassignQuestion(ctx, userID, state.QuestionID, authorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorz.ErrInvalid):
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "manage.reassign_unknown_user")})
+		case errors.Is(err, errorz.ErrConflict):
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "manage.reassign_same_author")})
+		case errors.Is(err, errorz.ErrNotFound):
+			_ = c.form.Cancel(ctx, userID)
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "question.unavailable")})
+		default:
+			log.Printf("reassign question: %v", err)
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.error")})
+		}
+		return
+	}
+	_ = c.form.Cancel(ctx, userID)
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "manage.reassigned", c.displayName(ctx, authorID))})
+	c.sendManagedQuestionCardWithMessage(ctx, chatID, q, state.AuthorID, state.Page, 0)
+}
+
+func (c *Controller) sendManagedQuestionsWithMessage(ctx context.Context, chatID, authorID int64, page int, messageID int) {
+	if page < 1 {
+		page = 1
+	}
+	res, err := c.admin.AllQuestions(ctx, authorID, page, pageSize)
+	if err != nil {
+		log.Printf("list managed questions: %v", err)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.error")})
+		return
+	}
+
+	totalPages := (res.Total + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+	if page > totalPages {
+		page = totalPages
+		res, err = c.admin.AllQuestions(ctx, authorID, page, pageSize)
+		if err != nil {
+			log.Printf("list managed questions: %v", err)
+			return
+		}
+	}
+
+	rows := make([][]models.InlineKeyboardButton, 0, len(res.Items)+4)
+	for i, q := range res.Items {
+		idx := (page-1)*pageSize + i + 1
+		rows = append(rows, []models.InlineKeyboardButton{{
+			Text:         fmt.Sprintf("%d) %s → %s", idx, shortText(q.QuestionText, 30), shortText(q.AnswerText, 15)),
+			CallbackData: fmt.Sprintf("sa:o:%s:%d:%d", q.ID, authorID, page),
+		}})
+	}
+	if totalPages > 1 {
+		nav := []models.InlineKeyboardButton{}
+		if page > 1 {
+			nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.prev"), CallbackData: fmt.Sprintf("sa:l:%d:%d", authorID, page-1)})
+		}
+		nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.page", page, totalPages), CallbackData: "noop"})
+		if page < totalPages {
+			nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.next"), CallbackData: fmt.Sprintf("sa:l:%d:%d", authorID, page+1)})
+		}
+		rows = append(rows, nav)
+	}
+	filter := []models.InlineKeyboardButton{{Text: tr(ctx, "manage.by_author"), CallbackData: "sa:au:1"}}
+	if authorID != 0 {
+		filter = append(filter, models.InlineKeyboardButton{Text: tr(ctx, "manage.all_authors"), CallbackData: "sa:l:0:1"})
+	}
+	rows = append(rows,
+		filter,
+		[]models.InlineKeyboardButton{{Text: tr(ctx, "manage.audit_button"), CallbackData: "sa:log:1"}},
+		[]models.InlineKeyboardButton{{Text: tr(ctx, "common.back"), CallbackData: "adm:menu"}},
+	)
+
+	text := tr(ctx, "manage.title", trn(ctx, "plural.questions", res.Total))
+	if authorID != 0 {
+		text = tr(ctx, "manage.title_author", c.displayName(ctx, authorID), authorID, trn(ctx, "plural.questions", res.Total))
+	}
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func (c *Controller) sendQuestionAuthorsWithMessage(ctx context.Context, chatID int64, page int, messageID int) {
+	if page < 1 {
+		page = 1
+	}
+	res, err := c.admin.QuestionAuthors(ctx, page, pageSize)
+	if err != nil {
+		log.Printf("list question authors: %v", err)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.error")})
+		return
+	}
+
+	totalPages := (res.Total + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+	rows := make([][]models.InlineKeyboardButton, 0, len(res.Items)+2)
+	for _, a := range res.Items {
+		rows = append(rows, []models.InlineKeyboardButton{{
+			Text:         fmt.Sprintf("%s · %d", c.displayName(ctx, a.AuthorID), a.Questions),
+			CallbackData: fmt.Sprintf("sa:l:%d:1", a.AuthorID),
+		}})
+	}
+	if totalPages > 1 {
+		nav := []models.InlineKeyboardButton{}
+		if page > 1 {
+			nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.prev"), CallbackData: fmt.Sprintf("sa:au:%d", page-1)})
+		}
+		nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.page", page, totalPages), CallbackData: "noop"})
+		if page < totalPages {
+			nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.next"), CallbackData: fmt.Sprintf("sa:au:%d", page+1)})
+		}
+		rows = append(rows, nav)
+	}
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back_to_list"), CallbackData: "sa:l:0:1"}})
+
+	text := tr(ctx, "manage.authors_title")
+	if res.Total == 0 {
+		text = tr(ctx, "manage.authors_empty")
+	}
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func (c *Controller) sendManagedQuestionCardWithMessage(ctx context.Context, chatID int64, q schema.Question, authorID int64, page int, messageID int) {
+	text := tr(ctx, "manage.card",
+		q.QuestionText,
+		q.AnswerText,
+		c.displayName(ctx, q.AuthorID),
+		q.AuthorID,
+		questionStatusTitle(ctx, q.Status),
+		categoryTitle(ctx, q.Category),
+		difficultyTitle(ctx, q.Difficulty),
+		languageTitle(ctx, q.Language),
+		q.Likes,
+		q.Dislikes,
+		q.CreatedAt.Format(tr(ctx, "layout.datetime")),
+		q.UpdatedAt.Format(tr(ctx, "layout.datetime")),
+	)
+	back := fmt.Sprintf("%s:%d:%d", q.ID, authorID, page)
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+		{{Text: tr(ctx, "common.edit"), CallbackData: "sa:e:" + back}},
+		{{Text: tr(ctx, "manage.reassign_button"), CallbackData: "sa:r:" + back}},
+		{{Text: tr(ctx, "manage.delete_button"), CallbackData: "sa:d:" + back}},
+		{{Text: tr(ctx, "manage.question_audit_button"), CallbackData: "sa:h:" + back}},
+		{{Text: tr(ctx, "common.back_to_list"), CallbackData: fmt.Sprintf("sa:l:%d:%d", authorID, page)}},
+	}}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func (c *Controller) auditEntryText(ctx context.Context, e schema.AuditEntry, withQuestion bool) string {
+	var action string
+	switch e.Action {
+	case schema.AuditActionEdit:
+		action = tr(ctx, "audit.edit")
+	case schema.AuditActionDelete:
+		action = tr(ctx, "audit.delete")
+	case schema.AuditActionReassign:
+		action = tr(ctx, "audit.reassign", c.displayName(ctx, e.FromAuthorID), c.displayName(ctx, e.ToAuthorID))
+	default:
+		action = string(e.Action)
+	}
+	line := tr(ctx, "audit.entry", e.CreatedAt.Format(tr(ctx, "layout.datetime")), c.displayName(ctx, e.ActorID), action)
+	if withQuestion {
+		question := shortText(e.QuestionText, 60)
+		if question == "" {
+			question = tr(ctx, "audit.question_purged")
+		}
+		line += "\n" + tr(ctx, "audit.question", question)
+	}
+	return line
+}
+
+func (c *Controller) sendQuestionAuditWithMessage(ctx context.Context, chatID int64, questionID string, authorID int64, page int, messageID int) {
+	res, err := c.admin.AuditLog(ctx, questionID, 1, pageSize)
+	if err != nil {
+		log.Printf("question audit log: %v", err)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.error")})
+		return
+	}
+	text := tr(ctx, "audit.question_empty")
+	if res.Total > 0 {
+		lines := []string{tr(ctx, "audit.question_title", res.Total)}
+		for _, e := range res.Items {
+			lines = append(lines, c.auditEntryText(ctx, e, false))
+		}
+		text = strings.Join(lines, "\n\n")
+	}
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+		{{Text: tr(ctx, "history.back_to_question"), CallbackData: fmt.Sprintf("sa:o:%s:%d:%d", questionID, authorID, page)}},
+	}}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func (c *Controller) sendAuditLogWithMessage(ctx context.Context, chatID int64, page int, messageID int) {
+	if page < 1 {
+		page = 1
+	}
+	res, err := c.admin.AuditLog(ctx, "", page, pageSize)
+	if err != nil {
+		log.Printf("audit log: %v", err)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.error")})
+		return
+	}
+
+	totalPages := (res.Total + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+	text := tr(ctx, "audit.empty")
+	if res.Total > 0 {
+		lines := []string{tr(ctx, "audit.title")}
+		for _, e := range res.Items {
+			lines = append(lines, c.auditEntryText(ctx, e, true))
+		}
+		text = strings.Join(lines, "\n\n")
+	}
+
+	var rows [][]models.InlineKeyboardButton
+	if totalPages > 1 {
+		nav := []models.InlineKeyboardButton{}
+		if page > 1 {
+			nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.prev"), CallbackData: fmt.Sprintf("sa:log:%d", page-1)})
+		}
+		nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.page", page, totalPages), CallbackData: "noop"})
+		if page < totalPages {
+			nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.next"), CallbackData: fmt.Sprintf("sa:log:%d", page+1)})
+		}
+		rows = append(rows, nav)
+	}
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back_to_list"), CallbackData: "sa:l:0:1"}})
+
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
@@ -0,0 +1,253 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/adapters/controller/telegram/i18n"
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+func (c *Controller) suggestCommand(ctx context.Context, b *tgbot.Bot, upd *models.Update) {
+	if upd.Message == nil || upd.Message.From == nil {
+		return
+	}
+	userID := upd.Message.From.ID
+	_ = c.users.TouchInteraction(ctx, userID)
+	_ = c.form.StartSuggest(ctx, userID, ctxLanguage(ctx))
+	_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: upd.Message.Chat.ID, Text: tr(ctx, "suggest.intro")})
+}
+
+func (c *Controller) handleModerationCallback(ctx context.Context, chatID, userID int64, messageID int, data string, ack func(string, bool)) {
+	if !c.access.Can(userID, schema.PermissionModerate) {
+		ack(tr(ctx, "common.forbidden"), true)
+		return
+	}
+	parts := strings.Split(data, ":")
+	if len(parts) < 3 {
+		return
+	}
+	if parts[1] == "list" {
+		page, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return
+		}
+		c.sendModerationListWithMessage(ctx, chatID, page, messageID)
+		return
+	}
+	if len(parts) < 4 {
+		return
+	}
+	qid := parts[2]
+	page, err := strconv.Atoi(parts[3])
+	if err != nil || !isValidUUID(qid) {
+		return
+	}
+
+	switch parts[1] {
+	case "open":
+		q, ok := c.pendingQuestion(ctx, qid, ack)
+		if !ok {
+			c.sendModerationListWithMessage(ctx, chatID, page, messageID)
+			return
+		}
+		c.sendModerationCardWithMessage(ctx, chatID, q, page, messageID)
+	case "ok":
+		q, err := c.admin.ApproveQuestion(ctx, userID, qid)
+		if err != nil {
+			if errors.Is(err, errorz.ErrNotFound) {
+				ack(tr(ctx, "moderation.already_processed"), true)
+				c.sendModerationListWithMessage(ctx, chatID, page, messageID)
+				return
+			}
+			log.Printf("approve question: %v", err)
+			ack(tr(ctx, "moderation.approve_failed"), true)
+			return
+		}
+		ack(tr(ctx, "moderation.approved"), false)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+			ChatID: q.AuthorID,
+			Text:   tr(c.recipientCtx(ctx, q.AuthorID), "moderation.approved_author") + q.QuestionText,
+		})
+		c.sendModerationListWithMessage(ctx, chatID, page, messageID)
+	case "edit":
+		q, ok := c.pendingQuestion(ctx, qid, ack)
+		if !ok {
+			return
+		}
+		_ = c.form.StartModerateEdit(ctx, userID, q.ID, page, schema.QuestionDraft{QuestionText: q.QuestionText, AnswerText: q.AnswerText, Category: q.Category, Difficulty: q.Difficulty, Language: q.Language})
+		c.sendChooseField(ctx, chatID)
+	case "rej":
+		if _, ok := c.pendingQuestion(ctx, qid, ack); !ok {
+			return
+		}
+		_ = c.form.StartReject(ctx, userID, qid, page)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+			ChatID: chatID,
+			Text:   tr(ctx, "moderation.reject_prompt"),
+		})
+	}
+}
+
+func (c *Controller) pendingQuestion(ctx context.Context, questionID string, ack func(string, bool)) (schema.Question, bool) {
+	q, err := c.admin.GetQuestion(ctx, questionID)
+	if err != nil {
+		if !errors.Is(err, errorz.ErrNotFound) {
+			log.Printf("get pending question: %v", err)
+		}
+		ack(tr(ctx, "question.unavailable"), true)
+		return schema.Question{}, false
+	}
+	if q.Status != schema.QuestionStatusDraft {
+		ack(tr(ctx, "moderation.already_processed"), true)
+		return schema.Question{}, false
+	}
+	return q, true
+}
+
+func (c *Controller) rejectWithReason(ctx context.Context, chatID, userID int64, state schema.FormState, reason string) {
+	if !c.access.Can(userID, schema.PermissionModerate) {
+		_ = c.form.Cancel(ctx, userID)
+		return
+	}
+	q, err := c.admin.RejectQuestion(ctx, userID, state.QuestionID, reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorz.ErrInvalid):
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "moderation.reason_empty")})
+		case errors.Is(err, errorz.ErrLimitExceeded):
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "moderation.reason_too_long")})
+		case errors.Is(err, errorz.ErrNotFound):
+			_ = c.form.Cancel(ctx, userID)
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "moderation.already_processed")})
+		default:
+			log.Printf("reject question: %v", err)
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "moderation.reject_failed")})
+		}
+		return
+	}
+	_ = c.form.Cancel(ctx, userID)
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID: q.AuthorID,
+		Text:   tr(c.recipientCtx(ctx, q.AuthorID), "moderation.rejected_author", q.QuestionText, strings.TrimSpace(reason)),
+	})
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "moderation.rejected")})
+	c.sendModerationListWithMessage(ctx, chatID, state.Page, 0)
+}
+
+func (c *Controller) notifyModerators(ctx context.Context, q schema.Question) {
+	author := c.displayName(ctx, q.AuthorID)
+	for _, adminID := range c.access.UserIDsWith(schema.PermissionModerate) {
+		adminCtx := c.recipientCtx(ctx, adminID)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+			ChatID: adminID,
+			Text:   tr(adminCtx, "moderation.new", author, q.QuestionText),
+			ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+				{{Text: tr(adminCtx, "common.open"), CallbackData: fmt.Sprintf("mod:open:%s:1", q.ID)}},
+			}},
+		})
+	}
+}
+
+func (c *Controller) sendModerationListWithMessage(ctx context.Context, chatID int64, page int, messageID int) {
+	if page < 1 {
+		page = 1
+	}
+	res, err := c.admin.PendingQuestions(ctx, page, pageSize)
+	if err != nil {
+		log.Printf("pending questions: %v", err)
+		return
+	}
+
+	totalPages := (res.Total + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+	if page > totalPages {
+		page = totalPages
+		res, err = c.admin.PendingQuestions(ctx, page, pageSize)
+		if err != nil {
+			log.Printf("pending questions: %v", err)
+			return
+		}
+	}
+
+	rows := make([][]models.InlineKeyboardButton, 0, len(res.Items)+2)
+	for i, q := range res.Items {
+		idx := (page-1)*pageSize + i + 1
+		rows = append(rows, []models.InlineKeyboardButton{{
+			Text:         fmt.Sprintf("%d) %s", idx, shortText(q.QuestionText, 35)),
+			CallbackData: fmt.Sprintf("mod:open:%s:%d", q.ID, page),
+		}})
+	}
+
+	nav := []models.InlineKeyboardButton{}
+	if page > 1 {
+		nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.prev"), CallbackData: fmt.Sprintf("mod:list:%d", page-1)})
+	}
+	nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.page", page, totalPages), CallbackData: "noop"})
+	if page < totalPages {
+		nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.next"), CallbackData: fmt.Sprintf("mod:list:%d", page+1)})
+	}
+	rows = append(rows, nav)
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back"), CallbackData: "adm:menu"}})
+
+	text := tr(ctx, "moderation.list", i18n.Count(res.Total))
+	if res.Total == 0 {
+		text = tr(ctx, "moderation.empty")
+	}
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func (c *Controller) sendModerationCardWithMessage(ctx context.Context, chatID int64, q schema.Question, page int, messageID int) {
+	text := tr(ctx, "moderation.card",
+		c.displayName(ctx, q.AuthorID),
+		q.CreatedAt.Format(tr(ctx, "layout.datetime")),
+		q.QuestionText,
+		q.AnswerText,
+		categoryTitle(ctx, q.Category),
+		difficultyTitle(ctx, q.Difficulty),
+		languageTitle(ctx, q.Language),
+	)
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+		{{Text: tr(ctx, "moderation.approve"), CallbackData: fmt.Sprintf("mod:ok:%s:%d", q.ID, page)}},
+		{{Text: tr(ctx, "common.edit"), CallbackData: fmt.Sprintf("mod:edit:%s:%d", q.ID, page)}},
+		{{Text: tr(ctx, "moderation.reject"), CallbackData: fmt.Sprintf("mod:rej:%s:%d", q.ID, page)}},
+		{{Text: tr(ctx, "common.back_to_list"), CallbackData: fmt.Sprintf("mod:list:%d", page)}},
+	}}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
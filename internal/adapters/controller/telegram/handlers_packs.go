@@ -0,0 +1,281 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/adapters/controller/telegram/i18n"
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/schema"
+	packsvc "LoudQuestionBot/internal/domain/service/pack"
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+func (c *Controller) handlePackCallback(ctx context.Context, chatID, userID int64, messageID int, data string, ack func(string, bool)) {
+	if !c.access.Can(userID, schema.PermissionAuthor) {
+		ack(tr(ctx, "common.forbidden"), true)
+		return
+	}
+	parts := strings.Split(data, ":")
+	if len(parts) < 2 {
+		return
+	}
+	switch parts[1] {
+	case "list":
+		c.sendPackListWithMessage(ctx, chatID, messageID)
+		return
+	case "new":
+		_ = c.form.StartPack(ctx, userID)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "pack.title_prompt", packsvc.MaxTitleLen)})
+		return
+	case "skip":
+		state, ok, err := c.form.Get(ctx, userID)
+		if err != nil || !ok || state.Step != schema.FormStepPackDescription {
+			ack(tr(ctx, "common.form_expired"), true)
+			return
+		}
+		c.createPack(ctx, chatID, userID, state)
+		return
+	}
+	if len(parts) < 3 || !isValidUUID(parts[2]) {
+		return
+	}
+	packID := parts[2]
+
+	switch parts[1] {
+	case "open":
+		c.sendPackCardWithMessage(ctx, chatID, packID, messageID)
+	case "pool":
+		p, err := c.packs.Get(ctx, packID)
+		if err != nil {
+			ack(tr(ctx, "pack.unavailable"), true)
+			return
+		}
+		_ = c.form.StartPoolCreate(ctx, userID, ctxLanguage(ctx), p.ID)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "pack.pool_target", p.Title) + "\n\n" + tr(ctx, "pool.prompt")})
+	case "delask":
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Text:      tr(ctx, "pack.delete_confirm"),
+			ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+				{{Text: tr(ctx, "pack.delete_yes"), CallbackData: "pk:del:" + packID}},
+				{{Text: tr(ctx, "common.no"), CallbackData: "pk:open:" + packID}},
+			}},
+		})
+	case "del":
+		if err := c.packs.Delete(ctx, packID); err != nil {
+			switch {
+			case errors.Is(err, errorz.ErrConflict):
+				ack(tr(ctx, "pack.delete_not_empty"), true)
+			case errors.Is(err, errorz.ErrNotFound):
+				ack(tr(ctx, "pack.unavailable"), true)
+			default:
+				log.Printf("delete pack: %v", err)
+				ack(tr(ctx, "pack.delete_failed"), true)
+			}
+			c.sendPackCardWithMessage(ctx, chatID, packID, messageID)
+			return
+		}
+		ack(tr(ctx, "pack.deleted"), false)
+		c.sendPackListWithMessage(ctx, chatID, messageID)
+	}
+}
+
+func (c *Controller) handlePackText(ctx context.Context, chatID, userID int64, state schema.FormState, text string) {
+	switch state.Step {
+	case schema.FormStepPackTitle:
+		if text == "" {
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "pack.title_prompt", packsvc.MaxTitleLen)})
+			return
+		}
+		if utf8.RuneCountInString(text) > packsvc.MaxTitleLen {
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "pack.title_too_long", packsvc.MaxTitleLen)})
+			return
+		}
+		state.Pack.Title = text
+		state.Step = schema.FormStepPackDescription
+		_ = c.form.Save(ctx, userID, state)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+			ChatID: chatID,
+			Text:   tr(ctx, "pack.description_prompt", packsvc.MaxDescriptionLen),
+			ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+				{{Text: tr(ctx, "pack.skip_description"), CallbackData: "pk:skip"}},
+			}},
+		})
+	case schema.FormStepPackDescription:
+		if utf8.RuneCountInString(text) > packsvc.MaxDescriptionLen {
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "pack.description_too_long", packsvc.MaxDescriptionLen)})
+			return
+		}
+		state.Pack.Description = text
+		c.createPack(ctx, chatID, userID, state)
+	}
+}
+
+func (c *Controller) createPack(ctx context.Context, chatID, userID int64, state schema.FormState) {
+	p, err := c.packs.Create(ctx, userID, state.Pack)
+	if err != nil {
+		if !errors.Is(err, errorz.ErrInvalid) && !errors.Is(err, errorz.ErrLimitExceeded) {
+			log.Printf("create pack: %v", err)
+		}
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "pack.create_failed")})
+		return
+	}
+	_ = c.form.Cancel(ctx, userID)
+	c.sendPackCardWithMessage(ctx, chatID, p.ID, 0)
+}
+
+func (c *Controller) sendPackListWithMessage(ctx context.Context, chatID int64, messageID int) {
+	packs, err := c.packs.List(ctx)
+	if err != nil {
+		log.Printf("list packs: %v", err)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.error")})
+		return
+	}
+	rows := make([][]models.InlineKeyboardButton, 0, len(packs)+2)
+	for _, p := range packs {
+		rows = append(rows, []models.InlineKeyboardButton{{
+			Text:         "📦 " + shortText(p.Title, 35) + " · " + i18n.FromContext(ctx).Num(p.Questions),
+			CallbackData: "pk:open:" + p.ID,
+		}})
+	}
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "pack.new_button"), CallbackData: "pk:new"}})
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back"), CallbackData: "adm:menu"}})
+
+	text := tr(ctx, "pack.list")
+	if len(packs) == 0 {
+		text = tr(ctx, "pack.list_empty")
+	}
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func (c *Controller) sendPackCardWithMessage(ctx context.Context, chatID int64, packID string, messageID int) {
+	p, err := c.packs.Get(ctx, packID)
+	if err != nil {
+		if !errors.Is(err, errorz.ErrNotFound) {
+			log.Printf("get pack: %v", err)
+		}
+		c.sendPackListWithMessage(ctx, chatID, messageID)
+		return
+	}
+	text := tr(ctx, "pack.card", p.Title, trn(ctx, "plural.questions", p.Questions))
+	if p.Description != "" {
+		text += "\n\n" + p.Description
+	}
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+		{{Text: tr(ctx, "pack.pool_button"), CallbackData: "pk:pool:" + p.ID}},
+		{{Text: tr(ctx, "common.delete"), CallbackData: "pk:delask:" + p.ID}},
+		{{Text: tr(ctx, "common.back_to_list"), CallbackData: "pk:list"}},
+	}}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func (c *Controller) sendPackPickerWithMessage(ctx context.Context, chatID int64, settings schema.PlaySettings, canEdit bool, messageID int) {
+	packs, err := c.packs.List(ctx)
+	if err != nil {
+		log.Printf("list packs: %v", err)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.error")})
+		return
+	}
+	text := tr(ctx, "play.packs_prompt")
+	if len(packs) == 0 {
+		text = tr(ctx, "play.packs_empty")
+	}
+	rows := make([][]models.InlineKeyboardButton, 0, len(packs)+2)
+	if canEdit {
+		general := tr(ctx, "play.packs_general_button")
+		if len(settings.Packs) == 0 {
+			general = "✅ " + general
+		}
+		rows = append(rows, []models.InlineKeyboardButton{{Text: general, CallbackData: "play:pack:all"}})
+		for _, p := range packs {
+			label := shortText(p.Title, 40)
+			if settings.HasPack(p.ID) {
+				label = "✅ " + label
+			}
+			rows = append(rows, []models.InlineKeyboardButton{{Text: label, CallbackData: "play:pack:" + p.ID}})
+		}
+		if len(packs) > 0 {
+			text += packDescriptions(packs)
+		}
+	} else {
+		text = tr(ctx, "label.packs") + c.packsSummary(ctx, settings) + tr(ctx, "play.packs_owner_note")
+	}
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back"), CallbackData: "play:menu"}})
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func (c *Controller) packsSummary(ctx context.Context, settings schema.PlaySettings) string {
+	if len(settings.Packs) == 0 {
+		return tr(ctx, "play.packs_general")
+	}
+	packs, err := c.packs.List(ctx)
+	if err != nil {
+		log.Printf("list packs: %v", err)
+		return trn(ctx, "plural.packs", len(settings.Packs))
+	}
+	titles := make([]string, 0, len(settings.Packs))
+	for _, p := range packs {
+		if settings.HasPack(p.ID) {
+			titles = append(titles, p.Title)
+		}
+	}
+	return strings.Join(titles, ", ")
+}
+
+func packDescriptions(packs []schema.Pack) string {
+	var b strings.Builder
+	for _, p := range packs {
+		if p.Description == "" {
+			continue
+		}
+		b.WriteString("\n\n📦 " + p.Title + " — " + shortText(p.Description, 200))
+	}
+	return b.String()
+}
@@ -0,0 +1,262 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/adapters/controller/telegram/i18n"
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+func (c *Controller) handleReportCallback(ctx context.Context, chatID, userID int64, messageID int, data string, ack func(string, bool)) {
+	if data == "rep:x" {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Text:      tr(ctx, "report.cancelled"),
+		})
+		return
+	}
+	qid, ok := parseStringPart(data, 1)
+	if !ok || !isValidUUID(qid) {
+		return
+	}
+	key, ok := parseStringPart(data, 2)
+	if !ok {
+		c.sendReportReasons(ctx, chatID, qid)
+		return
+	}
+
+	hidden, err := c.game.ReportQuestion(ctx, userID, qid, schema.ReportReason(key))
+	if err != nil {
+		switch {
+		case errors.Is(err, errorz.ErrAlreadyExists):
+			ack(tr(ctx, "report.already"), true)
+		case errors.Is(err, errorz.ErrNotFound):
+			ack(tr(ctx, "question.unavailable"), true)
+		case errors.Is(err, errorz.ErrInvalid):
+			ack(tr(ctx, "report.unknown_reason"), true)
+		default:
+			log.Printf("report question: %v", err)
+			ack(tr(ctx, "report.failed"), true)
+		}
+		return
+	}
+	text := tr(ctx, "report.sent")
+	if hidden {
+		text += tr(ctx, "report.hidden_note")
+	}
+	_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+		ChatID:    chatID,
+		MessageID: messageID,
+		Text:      text,
+	})
+}
+
+func (c *Controller) sendReportReasons(ctx context.Context, chatID int64, questionID string) {
+	rows := make([][]models.InlineKeyboardButton, 0, len(schema.ReportReasons)+1)
+	for _, reason := range schema.ReportReasons {
+		rows = append(rows, []models.InlineKeyboardButton{{
+			Text:         reportReasonTitle(ctx, reason),
+			CallbackData: fmt.Sprintf("rep:%s:%s", questionID, reason),
+		}})
+	}
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.cancel"), CallbackData: "rep:x"}})
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        tr(ctx, "report.prompt"),
+		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: rows},
+	})
+}
+
+func (c *Controller) handleReviewCallback(ctx context.Context, chatID, userID int64, messageID int, data string, ack func(string, bool)) {
+	if !c.access.Can(userID, schema.PermissionModerate) {
+		ack(tr(ctx, "common.forbidden"), true)
+		return
+	}
+	parts := strings.Split(data, ":")
+	if len(parts) < 3 {
+		return
+	}
+	if parts[1] == "list" {
+		page, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return
+		}
+		c.sendReportListWithMessage(ctx, chatID, page, messageID)
+		return
+	}
+	if len(parts) < 4 {
+		return
+	}
+	qid := parts[2]
+	page, err := strconv.Atoi(parts[3])
+	if err != nil || !isValidUUID(qid) {
+		return
+	}
+
+	switch parts[1] {
+	case "open":
+		item, err := c.admin.ReportedQuestion(ctx, qid)
+		if err != nil {
+			if !errors.Is(err, errorz.ErrNotFound) {
+				log.Printf("reported question: %v", err)
+			}
+			ack(tr(ctx, "review.closed"), true)
+			c.sendReportListWithMessage(ctx, chatID, page, messageID)
+			return
+		}
+		c.sendReportCardWithMessage(ctx, chatID, item, page, messageID)
+	case "edit":
+		item, err := c.admin.ReportedQuestion(ctx, qid)
+		if err != nil {
+			if !errors.Is(err, errorz.ErrNotFound) {
+				log.Printf("reported question: %v", err)
+			}
+			ack(tr(ctx, "review.closed"), true)
+			return
+		}
+		q := item.Question
+		_ = c.form.StartReviewEdit(ctx, userID, q.ID, page, schema.QuestionDraft{QuestionText: q.QuestionText, AnswerText: q.AnswerText, Category: q.Category, Difficulty: q.Difficulty, Language: q.Language})
+		c.sendChooseField(ctx, chatID)
+	case "off":
+		if err := c.admin.DeactivateReportedQuestion(ctx, userID, qid); err != nil {
+			if !errors.Is(err, errorz.ErrNotFound) {
+				log.Printf("deactivate reported question: %v", err)
+			}
+			ack(tr(ctx, "review.deactivate_failed"), true)
+			return
+		}
+		ack(tr(ctx, "review.deactivated"), false)
+		c.sendReportListWithMessage(ctx, chatID, page, messageID)
+	case "ok":
+		if err := c.admin.DismissReports(ctx, userID, qid); err != nil {
+			if !errors.Is(err, errorz.ErrNotFound) {
+				log.Printf("dismiss reports: %v", err)
+			}
+			ack(tr(ctx, "review.dismiss_failed"), true)
+			return
+		}
+		ack(tr(ctx, "review.dismissed"), false)
+		c.sendReportListWithMessage(ctx, chatID, page, messageID)
+	}
+}
+
+func (c *Controller) sendReportListWithMessage(ctx context.Context, chatID int64, page int, messageID int) {
+	if page < 1 {
+		page = 1
+	}
+	res, err := c.admin.ReportedQuestions(ctx, page, pageSize)
+	if err != nil {
+		log.Printf("reported questions: %v", err)
+		return
+	}
+
+	totalPages := (res.Total + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+	if page > totalPages {
+		page = totalPages
+		res, err = c.admin.ReportedQuestions(ctx, page, pageSize)
+		if err != nil {
+			log.Printf("reported questions: %v", err)
+			return
+		}
+	}
+
+	rows := make([][]models.InlineKeyboardButton, 0, len(res.Items)+2)
+	for _, item := range res.Items {
+		mark := ""
+		if item.Question.Status == schema.QuestionStatusHidden {
+			mark = "🙈 "
+		}
+		rows = append(rows, []models.InlineKeyboardButton{{
+			Text:         fmt.Sprintf("🚩%d %s%s", item.Reports, mark, shortText(item.Question.QuestionText, 35)),
+			CallbackData: fmt.Sprintf("rvw:open:%s:%d", item.Question.ID, page),
+		}})
+	}
+
+	nav := []models.InlineKeyboardButton{}
+	if page > 1 {
+		nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.prev"), CallbackData: fmt.Sprintf("rvw:list:%d", page-1)})
+	}
+	nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.page", page, totalPages), CallbackData: "noop"})
+	if page < totalPages {
+		nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.next"), CallbackData: fmt.Sprintf("rvw:list:%d", page+1)})
+	}
+	rows = append(rows, nav)
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back"), CallbackData: "adm:menu"}})
+
+	text := tr(ctx, "review.list", i18n.Count(res.Total))
+	if res.Total == 0 {
+		text = tr(ctx, "review.empty")
+	}
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func (c *Controller) sendReportCardWithMessage(ctx context.Context, chatID int64, item schema.ReportedQuestion, page int, messageID int) {
+	q := item.Question
+	lines := []string{
+		tr(ctx, "review.count", item.Reports),
+	}
+	for _, reason := range schema.ReportReasons {
+		if cnt := item.Reasons[reason]; cnt > 0 {
+			lines = append(lines, fmt.Sprintf("- %s: %d", reportReasonTitle(ctx, reason), cnt))
+		}
+	}
+	if q.Status == schema.QuestionStatusHidden {
+		lines = append(lines, tr(ctx, "review.hidden"))
+	}
+	lines = append(lines,
+		"",
+		tr(ctx, "label.question")+q.QuestionText,
+		tr(ctx, "label.answer")+q.AnswerText,
+		tr(ctx, "label.category")+categoryTitle(ctx, q.Category),
+		tr(ctx, "label.difficulty")+difficultyTitle(ctx, q.Difficulty),
+		tr(ctx, "label.language")+languageTitle(ctx, q.Language),
+		tr(ctx, "label.author")+c.displayName(ctx, q.AuthorID),
+	)
+	text := strings.Join(lines, "\n")
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+		{{Text: tr(ctx, "common.edit"), CallbackData: fmt.Sprintf("rvw:edit:%s:%d", q.ID, page)}},
+		{{Text: tr(ctx, "review.deactivate"), CallbackData: fmt.Sprintf("rvw:off:%s:%d", q.ID, page)}},
+		{{Text: tr(ctx, "review.dismiss"), CallbackData: fmt.Sprintf("rvw:ok:%s:%d", q.ID, page)}},
+		{{Text: tr(ctx, "common.back_to_list"), CallbackData: fmt.Sprintf("rvw:list:%d", page)}},
+	}}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
@@ -0,0 +1,214 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+var roleKeys = map[schema.Role]string{
+	schema.RoleOwner:     "role.owner",
+	schema.RoleAdmin:     "role.admin",
+	schema.RoleModerator: "role.moderator",
+	schema.RoleAuthor:    "role.author",
+}
+
+func roleTitle(ctx context.Context, r schema.Role) string {
+	if key, ok := roleKeys[r]; ok {
+		return tr(ctx, key)
+	}
+	return string(r)
+}
+
+func parseRole(raw string) (schema.Role, bool) {
+	r := schema.Role(strings.ToLower(strings.TrimSpace(raw)))
+	return r, r.Valid()
+}
+
+func (c *Controller) rolesCommand(ctx context.Context, b *tgbot.Bot, upd *models.Update) {
+	if upd.Message == nil || upd.Message.From == nil {
+		return
+	}
+	chatID := upd.Message.Chat.ID
+	userID := upd.Message.From.ID
+	_ = c.users.TouchInteraction(ctx, userID)
+	if !c.access.Can(userID, schema.PermissionManageRoles) {
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.forbidden")})
+		return
+	}
+	c.sendRolesWithMessage(ctx, chatID, userID, 0)
+}
+
+func (c *Controller) grantCommand(ctx context.Context, b *tgbot.Bot, upd *models.Update) {
+	if upd.Message == nil || upd.Message.From == nil {
+		return
+	}
+	chatID := upd.Message.Chat.ID
+	userID := upd.Message.From.ID
+	_ = c.users.TouchInteraction(ctx, userID)
+	if !c.access.Can(userID, schema.PermissionManageRoles) {
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.forbidden")})
+		return
+	}
+	args := strings.Fields(upd.Message.Text)
+	if len(args) != 3 {
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "roles.grant_usage")})
+		return
+	}
+	targetID, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "roles.grant_usage")})
+		return
+	}
+	role, ok := parseRole(args[2])
+	if !ok {
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "roles.grant_usage")})
+		return
+	}
+	if err := c.access.Grant(ctx, userID, targetID, role); err != nil {
+		c.sendRoleChangeError(ctx, chatID, err)
+		return
+	}
+	_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "roles.granted", c.displayName(ctx, targetID), roleTitle(ctx, role))})
+	targetCtx := c.recipientCtx(ctx, targetID)
+	_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: targetID, Text: tr(targetCtx, "roles.granted_user", roleTitle(targetCtx, role))})
+}
+
+func (c *Controller) revokeCommand(ctx context.Context, b *tgbot.Bot, upd *models.Update) {
+	if upd.Message == nil || upd.Message.From == nil {
+		return
+	}
+	chatID := upd.Message.Chat.ID
+	userID := upd.Message.From.ID
+	_ = c.users.TouchInteraction(ctx, userID)
+	if !c.access.Can(userID, schema.PermissionManageRoles) {
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.forbidden")})
+		return
+	}
+	args := strings.Fields(upd.Message.Text)
+	if len(args) != 2 {
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "roles.revoke_usage")})
+		return
+	}
+	targetID, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "roles.revoke_usage")})
+		return
+	}
+	if err := c.revokeRole(ctx, userID, targetID); err != nil {
+		c.sendRoleChangeError(ctx, chatID, err)
+		return
+	}
+	_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "roles.revoked", c.displayName(ctx, targetID))})
+}
+
+func (c *Controller) handleRoleCallback(ctx context.Context, chatID, userID int64, messageID int, data string, ack func(string, bool)) {
+	if !c.access.Can(userID, schema.PermissionManageRoles) {
+		ack(tr(ctx, "common.forbidden"), true)
+		return
+	}
+	if data == "role:list" {
+		c.sendRolesWithMessage(ctx, chatID, userID, messageID)
+		return
+	}
+	parts := strings.Split(data, ":")
+	if len(parts) < 3 {
+		return
+	}
+	targetID, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return
+	}
+	switch parts[1] {
+	case "ask":
+		role, _ := c.access.Role(targetID)
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Text:      tr(ctx, "roles.revoke_confirm", c.displayName(ctx, targetID), roleTitle(ctx, role)),
+			ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+				{{Text: tr(ctx, "roles.revoke_yes"), CallbackData: fmt.Sprintf("role:rv:%d", targetID)}},
+				{{Text: tr(ctx, "question.delete_no"), CallbackData: "role:list"}},
+			}},
+		})
+	case "rv":
+		if err := c.revokeRole(ctx, userID, targetID); err != nil {
+			if !errors.Is(err, errorz.ErrNotFound) && !errors.Is(err, errorz.ErrConflict) {
+				log.Printf("revoke role: %v", err)
+			}
+			ack(tr(ctx, "roles.revoke_failed"), true)
+			return
+		}
+		ack(tr(ctx, "roles.revoked", c.displayName(ctx, targetID)), false)
+		c.sendRolesWithMessage(ctx, chatID, userID, messageID)
+	}
+}
+
+func (c *Controller) revokeRole(ctx context.Context, actorID, targetID int64) error {
+	if err := c.access.Revoke(ctx, actorID, targetID); err != nil {
+		return err
+	}
+	targetCtx := c.recipientCtx(ctx, targetID)
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: targetID, Text: tr(targetCtx, "roles.revoked_user")})
+	return nil
+}
+
+func (c *Controller) sendRoleChangeError(ctx context.Context, chatID int64, err error) {
+	switch {
+	case errors.Is(err, errorz.ErrConflict):
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "roles.protected")})
+	case errors.Is(err, errorz.ErrNotFound):
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "roles.no_role")})
+	case errors.Is(err, errorz.ErrInvalid):
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "roles.grant_usage")})
+	case errors.Is(err, errorz.ErrForbidden):
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.forbidden")})
+	default:
+		log.Printf("change role: %v", err)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.error")})
+	}
+}
+
+func (c *Controller) sendRolesWithMessage(ctx context.Context, chatID, userID int64, messageID int) {
+	lines := []string{tr(ctx, "roles.title")}
+	var rows [][]models.InlineKeyboardButton
+	for _, m := range c.access.Members() {
+		line := tr(ctx, "roles.member", c.displayName(ctx, m.UserID), m.UserID, roleTitle(ctx, m.Role))
+		if c.access.IsBootstrapOwner(m.UserID) {
+			line += tr(ctx, "roles.bootstrap")
+		} else if m.UserID != userID {
+			rows = append(rows, []models.InlineKeyboardButton{{
+				Text:         tr(ctx, "roles.revoke_button", c.displayName(ctx, m.UserID)),
+				CallbackData: fmt.Sprintf("role:ask:%d", m.UserID),
+			}})
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, tr(ctx, "roles.help"))
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back"), CallbackData: "adm:menu"}})
+
+	text := strings.Join(lines, "\n")
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
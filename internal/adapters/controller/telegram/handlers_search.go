@@ -0,0 +1,132 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/schema"
+	adminsvc "LoudQuestionBot/internal/domain/service/admin"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"unicode/utf8"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+func (c *Controller) handleSearchCallback(ctx context.Context, chatID, userID int64, messageID int, data string, ack func(string, bool)) {
+	if !c.access.Can(userID, schema.PermissionAuthor) {
+		return
+	}
+	if data == "adm:find" {
+		_ = c.form.StartSearch(ctx, userID)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+			ChatID: chatID,
+			Text:   tr(ctx, "search.prompt", adminsvc.MaxSearchLen),
+			ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+				{{Text: tr(ctx, "common.cancel"), CallbackData: "frm:x"}},
+			}},
+		})
+		return
+	}
+	page, ok := parseIntPart(data, 2)
+	if !ok {
+		return
+	}
+	state, ok, err := c.form.Get(ctx, userID)
+	if err != nil || !ok || state.Mode != schema.FormModeSearch || state.Search == "" {
+		ack(tr(ctx, "common.form_expired"), true)
+		return
+	}
+	c.sendSearchResultsWithMessage(ctx, chatID, userID, state.Search, page, messageID)
+}
+
+func (c *Controller) handleSearchText(ctx context.Context, chatID, userID int64, state schema.FormState, text string) {
+	if text == "" {
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "search.prompt", adminsvc.MaxSearchLen)})
+		return
+	}
+	if utf8.RuneCountInString(text) > adminsvc.MaxSearchLen {
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "search.too_long", adminsvc.MaxSearchLen)})
+		return
+	}
+	state.Search = text
+	state.Step = schema.FormStepSearchResults
+	c.sendSearchResultsWithMessage(ctx, chatID, userID, text, 1, 0)
+	_ = c.form.Save(ctx, userID, state)
+}
+
+func (c *Controller) sendSearchResultsWithMessage(ctx context.Context, chatID, userID int64, query string, page int, messageID int) {
+	if page < 1 {
+		page = 1
+	}
+	res, err := c.admin.SearchMyQuestions(ctx, userID, query, page, pageSize)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorz.ErrLimitExceeded):
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "search.too_long", adminsvc.MaxSearchLen)})
+		case errors.Is(err, errorz.ErrInvalid):
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "search.prompt", adminsvc.MaxSearchLen)})
+		default:
+			log.Printf("search questions: %v", err)
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.error")})
+		}
+		return
+	}
+
+	totalPages := (res.Total + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+	if page > totalPages {
+		page = totalPages
+		res, err = c.admin.SearchMyQuestions(ctx, userID, query, page, pageSize)
+		if err != nil {
+			log.Printf("search questions: %v", err)
+			return
+		}
+	}
+
+	rows := make([][]models.InlineKeyboardButton, 0, len(res.Items)+3)
+	for i, q := range res.Items {
+		idx := (page-1)*pageSize + i + 1
+		rows = append(rows, []models.InlineKeyboardButton{{
+			Text:         fmt.Sprintf("%d) %s%s → %s", idx, questionStatusBadge(q.Status), shortText(q.QuestionText, 30), shortText(q.AnswerText, 15)),
+			CallbackData: fmt.Sprintf("adm:open:%s:1", q.ID),
+		}})
+	}
+	if totalPages > 1 {
+		nav := []models.InlineKeyboardButton{}
+		if page > 1 {
+			nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.prev"), CallbackData: fmt.Sprintf("adm:fnd:%d", page-1)})
+		}
+		nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.page", page, totalPages), CallbackData: "noop"})
+		if page < totalPages {
+			nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.next"), CallbackData: fmt.Sprintf("adm:fnd:%d", page+1)})
+		}
+		rows = append(rows, nav)
+	}
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back_to_list"), CallbackData: "adm:list:1"}})
+
+	text := tr(ctx, "search.results", shortText(query, 50), trn(ctx, "plural.questions", res.Total))
+	if res.Total == 0 {
+		text = tr(ctx, "search.empty", shortText(query, 50))
+	}
+	text += tr(ctx, "search.again")
+
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
@@ -0,0 +1,208 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/adapters/controller/telegram/i18n"
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/schema"
+	sessionsvc "LoudQuestionBot/internal/domain/service/session"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+const gamesHistoryLimit = 5
+
+func (c *Controller) newGameCommand(ctx context.Context, b *tgbot.Bot, upd *models.Update) {
+	if upd.Message == nil || upd.Message.From == nil {
+		return
+	}
+	userID := upd.Message.From.ID
+	_ = c.users.TouchInteraction(ctx, userID)
+	c.startGame(ctx, upd.Message.Chat.ID, userID, upd.Message.Text)
+}
+
+func (c *Controller) endGameCommand(ctx context.Context, b *tgbot.Bot, upd *models.Update) {
+	if upd.Message == nil || upd.Message.From == nil {
+		return
+	}
+	_ = c.users.TouchInteraction(ctx, upd.Message.From.ID)
+	c.endGame(ctx, upd.Message.Chat.ID)
+}
+
+func (c *Controller) gamesCommand(ctx context.Context, b *tgbot.Bot, upd *models.Update) {
+	if upd.Message == nil || upd.Message.From == nil {
+		return
+	}
+	chatID := upd.Message.Chat.ID
+	_ = c.users.TouchInteraction(ctx, upd.Message.From.ID)
+
+	history, err := c.session.History(ctx, chatID, gamesHistoryLimit)
+	if err != nil {
+		log.Printf("games history: %v", err)
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "session.history_failed")})
+		return
+	}
+	if len(history) == 0 {
+		_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "session.history_empty")})
+		return
+	}
+	blocks := make([]string, 0, len(history)+1)
+	blocks = append(blocks, tr(ctx, "session.history_title"))
+	for _, summary := range history {
+		blocks = append(blocks, c.formatGameSummary(ctx, summary))
+	}
+	_, _ = b.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID: chatID,
+		Text:   strings.Join(blocks, "\n\n"),
+	})
+}
+
+func (c *Controller) startGame(ctx context.Context, chatID, userID int64, text string) {
+	rounds, duration, ok := parseGameLimit(text)
+	if !ok {
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+			ChatID: chatID,
+			Text:   tr(ctx, "session.newgame_usage"),
+		})
+		return
+	}
+	teamID := ""
+	if t, inTeam, err := c.team.GetByUserID(ctx, userID); err == nil && inTeam {
+		teamID = t.ID
+	}
+	if _, err := c.session.Start(ctx, chatID, userID, teamID, rounds, duration); err != nil {
+		if errors.Is(err, errorz.ErrConflict) {
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "session.already_running")})
+			return
+		}
+		log.Printf("start game session: %v", err)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "session.start_failed")})
+		return
+	}
+
+	limit := tr(ctx, "session.no_limit")
+	switch {
+	case rounds > 0:
+		limit = trn(ctx, "plural.questions", rounds)
+	case duration > 0:
+		limit = formatElapsed(ctx, duration)
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID: chatID,
+		Text:   tr(ctx, "session.started", limit),
+	})
+	if isPrivateChatID(chatID, userID) {
+		c.sendNextQuestion(ctx, chatID, userID)
+		return
+	}
+	if err := c.postGroupQuestion(ctx, chatID, userID); err != nil {
+		log.Printf("group next question: %v", err)
+	}
+}
+
+func (c *Controller) endGame(ctx context.Context, chatID int64) {
+	summary, err := c.session.End(ctx, chatID)
+	if err != nil {
+		if errors.Is(err, sessionsvc.ErrNoActiveSession) {
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "session.none")})
+			return
+		}
+		log.Printf("end game session: %v", err)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "session.end_failed")})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID: chatID,
+		Text:   tr(ctx, "session.ended") + c.formatGameSummary(ctx, summary),
+	})
+}
+
+func (c *Controller) postSummaryIfLimitReached(ctx context.Context, chatID int64) bool {
+	summary, finished, err := c.session.BeforeDraw(ctx, chatID)
+	if err != nil {
+		log.Printf("check session limit: %v", err)
+		return false
+	}
+	if !finished {
+		return false
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID: chatID,
+		Text:   tr(ctx, "session.limit_reached") + c.formatGameSummary(ctx, summary) + tr(ctx, "session.new_hint"),
+	})
+	return true
+}
+
+func (c *Controller) formatGameSummary(ctx context.Context, summary schema.GameSummary) string {
+	lines := []string{
+		tr(ctx, "session.game_from") + summary.Session.StartedAt.Format(tr(ctx, "layout.datetime")),
+		tr(ctx, "session.rounds_played", i18n.Count(summary.Played)),
+		tr(ctx, "session.answers_revealed", i18n.Count(summary.Revealed)),
+		tr(ctx, "session.duration") + formatElapsed(ctx, summary.Elapsed),
+	}
+	if len(summary.Scores) == 0 {
+		return strings.Join(lines, "\n")
+	}
+
+	type scoreLine struct {
+		userID int64
+		points int
+	}
+	scores := make([]scoreLine, 0, len(summary.Scores))
+	for userID, points := range summary.Scores {
+		scores = append(scores, scoreLine{userID: userID, points: points})
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].points == scores[j].points {
+			return scores[i].userID < scores[j].userID
+		}
+		return scores[i].points > scores[j].points
+	})
+	lines = append(lines, tr(ctx, "session.scores"))
+	for _, s := range scores {
+		lines = append(lines, fmt.Sprintf("- %s: %d", c.displayName(ctx, s.userID), s.points))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func parseGameLimit(text string) (int, time.Duration, bool) {
+	args := strings.Fields(strings.TrimSpace(text))
+	if len(args) == 1 {
+		return 0, 0, true
+	}
+	if len(args) != 2 {
+		return 0, 0, false
+	}
+	if n, err := strconv.Atoi(args[1]); err == nil {
+		return n, 0, n > 0
+	}
+	d, err := time.ParseDuration(args[1])
+	if err != nil || d < time.Minute {
+		return 0, 0, false
+	}
+	return 0, d, true
+}
+
+func formatElapsed(ctx context.Context, d time.Duration) string {
+	if d < time.Minute {
+		return tr(ctx, "duration.less_minute")
+	}
+	h := int(d.Hours())
+	m := int(d.Minutes()) % 60
+	if h == 0 {
+		return tr(ctx, "duration.minutes", m)
+	}
+	return tr(ctx, "duration.hours_minutes", h, m)
+}
+
+func isPrivateChatID(chatID, userID int64) bool {
+	return chatID == userID
+}
@@ -0,0 +1,35 @@
+package telegram
+
+import (
+	"context"
+	"log"
+	"strings"
+)
+
+func (c *Controller) handleSubscriptionCallback(ctx context.Context, chatID, userID int64, messageID int, data string, ack func(string, bool)) {
+	parts := strings.Split(data, ":")
+	if len(parts) < 2 {
+		return
+	}
+	switch parts[1] {
+	case "on":
+		if err := c.subs.Subscribe(ctx, userID); err != nil {
+			log.Printf("subscribe: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		ack(tr(ctx, "sub.enabled"), true)
+	case "off":
+		if err := c.subs.Unsubscribe(ctx, userID); err != nil {
+			log.Printf("unsubscribe: %v", err)
+			ack(tr(ctx, "common.error"), true)
+			return
+		}
+		ack(tr(ctx, "sub.disabled"), true)
+	default:
+		return
+	}
+	if len(parts) > 2 && parts[2] == "menu" {
+		c.sendPlayMenuWithMessage(ctx, chatID, userID, messageID)
+	}
+}
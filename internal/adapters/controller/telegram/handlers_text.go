@@ -13,7 +13,7 @@ import (
 
 func (c *Controller) handleText(ctx context.Context, upd *models.Update) {
 	msg := upd.Message
-	if msg == nil || msg.From == nil {
+	if msg == nil || msg.From == nil || msg.Chat.Type != models.ChatTypePrivate {
 		return
 	}
 	userID := msg.From.ID
@@ -33,44 +33,50 @@ func (c *Controller) handleText(ctx context.Context, upd *models.Update) {
 		return
 	}
 	if !ok {
-		if text == "Играть" {
+		if text == tr(ctx, "menu.play") {
 			c.sendNextQuestion(ctx, chatID, userID)
 			return
 		}
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Используйте /menu"})
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.use_menu")})
 		return
 	}
 
 	switch state.Step {
 	case schema.FormStepQuestion:
 		if utf8.RuneCountInString(text) > 250 {
-			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Вопрос не должен быть длиннее 250 символов"})
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.question_too_long")})
 			return
 		}
 		state.Draft.QuestionText = text
 		state.Step = schema.FormStepAnswer
 		_ = c.form.Save(ctx, userID, state)
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Напишите ответ"})
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.answer_prompt")})
 	case schema.FormStepAnswer:
 		if utf8.RuneCountInString(text) > 250 {
-			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Ответ не должен быть длиннее 250 символов"})
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.answer_too_long")})
 			return
 		}
 		state.Draft.AnswerText = text
-		state.Step = schema.FormStepPreview
+		if state.Draft.TranslationOf != "" {
+			state.Step = schema.FormStepPreview
+			_ = c.form.Save(ctx, userID, state)
+			c.sendDraftPreview(ctx, chatID, state)
+			return
+		}
+		state.Step = schema.FormStepCategory
 		_ = c.form.Save(ctx, userID, state)
-		c.sendDraftPreview(ctx, chatID, state)
+		c.sendCategoryChooser(ctx, chatID)
 	case schema.FormStepEditInput:
 		switch state.Field {
 		case schema.FormFieldQuestion:
 			if utf8.RuneCountInString(text) > 250 {
-				_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Вопрос не должен быть длиннее 250 символов"})
+				_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.question_too_long")})
 				return
 			}
 			state.Draft.QuestionText = text
 		case schema.FormFieldAnswer:
 			if utf8.RuneCountInString(text) > 250 {
-				_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Ответ не должен быть длиннее 250 символов"})
+				_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.answer_too_long")})
 				return
 			}
 			state.Draft.AnswerText = text
@@ -79,11 +85,15 @@ func (c *Controller) handleText(ctx context.Context, upd *models.Update) {
 		_ = c.form.Save(ctx, userID, state)
 		c.sendDraftPreview(ctx, chatID, state)
 	case schema.FormStepPoolInput:
-		items, err := parsePoolQuestions(text)
+		items, err := parsePoolQuestions(ctx, text)
 		if err != nil {
-			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Ошибка парсинга: " + err.Error()})
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "pool.parse_error") + err.Error()})
 			return
 		}
+		for i := range items {
+			items[i].Language = state.Draft.Language
+			items[i].PackID = state.Draft.PackID
+		}
 		state.Step = schema.FormStepPoolPreview
 		state.PoolItems = items
 		state.PoolIndex = 0
@@ -92,28 +102,28 @@ func (c *Controller) handleText(ctx context.Context, upd *models.Update) {
 		c.sendPoolPreview(ctx, chatID, state)
 	case schema.FormStepPoolEditQ:
 		if utf8.RuneCountInString(text) > 250 {
-			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Вопрос не должен быть длиннее 250 символов"})
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.question_too_long")})
 			return
 		}
 		if strings.TrimSpace(text) == "" {
-			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Вопрос не может быть пустым"})
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.question_empty")})
 			return
 		}
 		state.Draft.QuestionText = strings.TrimSpace(text)
 		state.Step = schema.FormStepPoolEditA
 		_ = c.form.Save(ctx, userID, state)
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Введите ответ"})
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.enter_answer")})
 	case schema.FormStepPoolEditA:
 		if utf8.RuneCountInString(text) > 250 {
-			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Ответ не должен быть длиннее 250 символов"})
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.answer_too_long")})
 			return
 		}
 		if strings.TrimSpace(text) == "" {
-			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Ответ не может быть пустым"})
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "form.answer_empty")})
 			return
 		}
 		if state.PoolIndex < 0 || state.PoolIndex >= len(state.PoolItems) {
-			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Форма устарела, начните заново"})
+			_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.form_expired")})
 			_ = c.form.Cancel(ctx, userID)
 			return
 		}
@@ -121,11 +131,25 @@ func (c *Controller) handleText(ctx context.Context, upd *models.Update) {
 		state.PoolItems[state.PoolIndex] = schema.QuestionDraft{
 			QuestionText: state.Draft.QuestionText,
 			AnswerText:   state.Draft.AnswerText,
+			Category:     state.PoolItems[state.PoolIndex].Category,
+			Difficulty:   state.PoolItems[state.PoolIndex].Difficulty,
+			Language:     state.PoolItems[state.PoolIndex].Language,
+			PackID:       state.PoolItems[state.PoolIndex].PackID,
 		}
 		state.Step = schema.FormStepPoolPreview
 		_ = c.form.Save(ctx, userID, state)
 		c.sendPoolPreview(ctx, chatID, state)
+	case schema.FormStepRejectReason:
+		c.rejectWithReason(ctx, chatID, userID, state, text)
+	case schema.FormStepBroadcastText, schema.FormStepBroadcastDays, schema.FormStepBroadcastPreview:
+		c.handleBroadcastText(ctx, chatID, userID, state, text)
+	case schema.FormStepPackTitle, schema.FormStepPackDescription:
+		c.handlePackText(ctx, chatID, userID, state, text)
+	case schema.FormStepSearchQuery, schema.FormStepSearchResults:
+		c.handleSearchText(ctx, chatID, userID, state, text)
+	case schema.FormStepReassignAuthor:
+		c.handleReassignText(ctx, chatID, userID, state, text)
 	default:
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Используйте кнопки под сообщением"})
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.use_buttons")})
 	}
 }
@@ -0,0 +1,191 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+func (c *Controller) handleTrashCallback(ctx context.Context, chatID, userID int64, messageID int, data string, ack func(string, bool)) {
+	if !c.access.Can(userID, schema.PermissionAuthor) {
+		return
+	}
+	parts := strings.Split(data, ":")
+	if len(parts) < 3 {
+		return
+	}
+	if parts[1] == "list" {
+		page, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return
+		}
+		c.sendTrashWithMessage(ctx, chatID, userID, page, messageID)
+		return
+	}
+	if len(parts) < 4 || !isValidUUID(parts[2]) {
+		return
+	}
+	qid := parts[2]
+	page, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return
+	}
+
+	switch parts[1] {
+	case "open":
+		c.sendTrashCardWithMessage(ctx, chatID, userID, qid, page, messageID)
+	case "rst":
+		if err := c.trash.Restore(ctx, userID, qid); err != nil {
+			if !errors.Is(err, errorz.ErrNotFound) {
+				log.Printf("restore question: %v", err)
+			}
+			ack(tr(ctx, "trash.restore_failed"), true)
+			return
+		}
+		ack(tr(ctx, "trash.restored"), false)
+		c.sendTrashWithMessage(ctx, chatID, userID, page, messageID)
+	case "purgeask":
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Text:      tr(ctx, "trash.purge_confirm"),
+			ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+				{{Text: tr(ctx, "trash.purge_yes"), CallbackData: fmt.Sprintf("trash:purge:%s:%d", qid, page)}},
+				{{Text: tr(ctx, "question.delete_no"), CallbackData: fmt.Sprintf("trash:open:%s:%d", qid, page)}},
+			}},
+		})
+	case "purge":
+		if err := c.trash.Purge(ctx, userID, qid); err != nil {
+			if !errors.Is(err, errorz.ErrNotFound) {
+				log.Printf("purge question: %v", err)
+			}
+			ack(tr(ctx, "trash.purge_failed"), true)
+			return
+		}
+		ack(tr(ctx, "trash.purged"), false)
+		c.sendTrashWithMessage(ctx, chatID, userID, page, messageID)
+	}
+}
+
+func (c *Controller) trashRetentionNote(ctx context.Context) string {
+	days := int(c.trash.Retention().Hours() / 24)
+	if days <= 0 {
+		return tr(ctx, "trash.retention_off")
+	}
+	return tr(ctx, "trash.retention", trn(ctx, "plural.days", days))
+}
+
+func (c *Controller) sendTrashWithMessage(ctx context.Context, chatID, userID int64, page int, messageID int) {
+	if page < 1 {
+		page = 1
+	}
+	res, err := c.trash.List(ctx, userID, page, pageSize)
+	if err != nil {
+		log.Printf("list trash: %v", err)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.error")})
+		return
+	}
+
+	totalPages := (res.Total + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+	if page > totalPages {
+		page = totalPages
+		res, err = c.trash.List(ctx, userID, page, pageSize)
+		if err != nil {
+			log.Printf("list trash: %v", err)
+			return
+		}
+	}
+
+	rows := make([][]models.InlineKeyboardButton, 0, len(res.Items)+2)
+	for i, q := range res.Items {
+		idx := (page-1)*pageSize + i + 1
+		rows = append(rows, []models.InlineKeyboardButton{{
+			Text:         fmt.Sprintf("%d) %s · %s", idx, shortText(q.QuestionText, 30), q.DeletedAt.Format(tr(ctx, "layout.datetime_short"))),
+			CallbackData: fmt.Sprintf("trash:open:%s:%d", q.ID, page),
+		}})
+	}
+	if totalPages > 1 {
+		nav := []models.InlineKeyboardButton{}
+		if page > 1 {
+			nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.prev"), CallbackData: fmt.Sprintf("trash:list:%d", page-1)})
+		}
+		nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.page", page, totalPages), CallbackData: "noop"})
+		if page < totalPages {
+			nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.next"), CallbackData: fmt.Sprintf("trash:list:%d", page+1)})
+		}
+		rows = append(rows, nav)
+	}
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back"), CallbackData: "adm:menu"}})
+
+	text := tr(ctx, "trash.title") + "\n" + c.trashRetentionNote(ctx)
+	if res.Total == 0 {
+		text = tr(ctx, "trash.empty")
+	}
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func (c *Controller) sendTrashCardWithMessage(ctx context.Context, chatID, userID int64, questionID string, page int, messageID int) {
+	q, err := c.trash.Get(ctx, userID, questionID)
+	if err != nil {
+		if !errors.Is(err, errorz.ErrNotFound) {
+			log.Printf("get deleted question: %v", err)
+		}
+		c.sendTrashWithMessage(ctx, chatID, userID, page, messageID)
+		return
+	}
+	text := tr(ctx, "trash.card",
+		q.QuestionText,
+		q.AnswerText,
+		categoryTitle(ctx, q.Category),
+		difficultyTitle(ctx, q.Difficulty),
+		languageTitle(ctx, q.Language),
+		q.DeletedAt.Format(tr(ctx, "layout.datetime")),
+	)
+	if purgeAt, ok := c.trash.PurgeAt(q); ok {
+		text += tr(ctx, "trash.purge_at", purgeAt.Format(tr(ctx, "layout.datetime")))
+	}
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+		{{Text: tr(ctx, "trash.restore_button"), CallbackData: fmt.Sprintf("trash:rst:%s:%d", q.ID, page)}},
+		{{Text: tr(ctx, "trash.purge_button"), CallbackData: fmt.Sprintf("trash:purgeask:%s:%d", q.ID, page)}},
+		{{Text: tr(ctx, "common.back_to_list"), CallbackData: fmt.Sprintf("trash:list:%d", page)}},
+	}}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
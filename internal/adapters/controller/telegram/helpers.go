@@ -1,9 +1,10 @@
 package telegram
 
 import (
+	"LoudQuestionBot/internal/adapters/controller/telegram/i18n"
 	"LoudQuestionBot/internal/domain/schema"
 	"context"
-	"fmt"
+	"errors"
 	"regexp"
 	"strconv"
 	"strings"
@@ -98,9 +99,108 @@ const maxLen = 250
 	return string(r[:maxLen-1]) + "…"
 }
 
-var poolLineRx = regexp.MustCompile(`^\s*\[(.*?)\]\s*-\s*\[(.*?)\]\s*$`)
+var poolLineRx = regexp.MustCompile(`^\s*\[(.*?)\]\s*-\s*\[(.*?)\]\s*(?:-\s*\[(.*?)\]\s*)?(?:-\s*\[(.*?)\]\s*)?$`)
 
-func parsePoolQuestions(text string) ([]schema.QuestionDraft, error) {
+var categoryKeys = map[schema.QuestionCategory]string{
+	schema.CategoryHistory:    "category.history",
+	schema.CategoryMovies:     "category.movies",
+	schema.CategoryScience:    "category.science",
+	schema.CategoryGeography:  "category.geography",
+	schema.CategorySport:      "category.sport",
+	schema.CategoryMusic:      "category.music",
+	schema.CategoryLiterature: "category.literature",
+	schema.CategoryOther:      "category.other",
+}
+
+func categoryTitle(ctx context.Context, c schema.QuestionCategory) string {
+	if key, ok := categoryKeys[c]; ok {
+		return tr(ctx, key)
+	}
+	return tr(ctx, categoryKeys[schema.CategoryOther])
+}
+
+var difficultyKeys = map[schema.QuestionDifficulty]string{
+	schema.DifficultyEasy:   "difficulty.easy",
+	schema.DifficultyMedium: "difficulty.medium",
+	schema.DifficultyHard:   "difficulty.hard",
+	schema.DifficultyMixed:  "difficulty.mixed",
+}
+
+func difficultyTitle(ctx context.Context, d schema.QuestionDifficulty) string {
+	if key, ok := difficultyKeys[d]; ok {
+		return tr(ctx, key)
+	}
+	return tr(ctx, difficultyKeys[schema.DifficultyMixed])
+}
+
+var reportReasonKeys = map[schema.ReportReason]string{
+	schema.ReportReasonWrongAnswer: "reason.wrong",
+	schema.ReportReasonOffensive:   "reason.offensive",
+	schema.ReportReasonDuplicate:   "reason.duplicate",
+	schema.ReportReasonOther:       "reason.other",
+}
+
+func reportReasonTitle(ctx context.Context, r schema.ReportReason) string {
+	if key, ok := reportReasonKeys[r]; ok {
+		return tr(ctx, key)
+	}
+	return tr(ctx, reportReasonKeys[schema.ReportReasonOther])
+}
+
+var questionStatusKeys = map[schema.QuestionStatus]string{
+	schema.QuestionStatusActive:   "status.active",
+	schema.QuestionStatusDraft:    "status.draft",
+	schema.QuestionStatusHidden:   "status.hidden",
+	schema.QuestionStatusRejected: "status.rejected",
+	schema.QuestionStatusDeleted:  "status.deleted",
+}
+
+var questionStatusBadges = map[schema.QuestionStatus]string{
+	schema.QuestionStatusDraft:  "⏳ ",
+	schema.QuestionStatusHidden: "🚫 ",
+}
+
+func questionStatusBadge(s schema.QuestionStatus) string {
+	return questionStatusBadges[s]
+}
+
+func questionStatusTitle(ctx context.Context, s schema.QuestionStatus) string {
+	if key, ok := questionStatusKeys[s]; ok {
+		return tr(ctx, key)
+	}
+	return string(s)
+}
+
+func parseDifficulty(raw string) (schema.QuestionDifficulty, bool) {
+	v := strings.ToLower(strings.TrimSpace(raw))
+	for _, d := range schema.QuestionDifficulties {
+		if v == string(d) || matchesTitle(v, difficultyKeys[d]) {
+			return d, true
+		}
+	}
+	return "", false
+}
+
+func parseCategory(raw string) (schema.QuestionCategory, bool) {
+	v := strings.ToLower(strings.TrimSpace(raw))
+	for _, c := range schema.QuestionCategories {
+		if v == string(c) || matchesTitle(v, categoryKeys[c]) {
+			return c, true
+		}
+	}
+	return "", false
+}
+
+func matchesTitle(v, key string) bool {
+	for _, lang := range i18n.Langs {
+		if v == strings.ToLower(i18n.For(lang).T(key)) {
+			return true
+		}
+	}
+	return false
+}
+
+func parsePoolQuestions(ctx context.Context, text string) ([]schema.QuestionDraft, error) {
 	lines := strings.Split(text, "\n")
 	out := make([]schema.QuestionDraft, 0, len(lines))
 	for i, raw := range lines {
@@ -109,28 +209,61 @@ func parsePoolQuestions(text string) ([]schema.QuestionDraft, error) {
 			continue
 		}
 		m := poolLineRx.FindStringSubmatch(line)
-		if len(m) != 3 {
-			return nil, fmt.Errorf("строка %d: ожидается формат [вопрос]-[ответ]", i+1)
+		if len(m) != 5 {
+			return nil, errors.New(tr(ctx, "pool.err_format", i+1))
 		}
-		q := strings.TrimSpace(m[1])
-		a := strings.TrimSpace(m[2])
-		if q == "" || a == "" {
-			return nil, fmt.Errorf("строка %d: вопрос и ответ не могут быть пустыми", i+1)
+		draft, err := poolDraft(ctx, i+1, m[1], m[2], m[3], m[4])
+		if err != nil {
+			return nil, err
 		}
-		if utf8.RuneCountInString(q) > 250 || utf8.RuneCountInString(a) > 250 {
-			return nil, fmt.Errorf("строка %d: лимит 250 символов на вопрос и ответ", i+1)
-		}
-		out = append(out, schema.QuestionDraft{QuestionText: q, AnswerText: a})
+		out = append(out, draft)
 	}
 	if len(out) == 0 {
-		return nil, fmt.Errorf("не найдено ни одного вопроса")
+		return nil, errors.New(tr(ctx, "pool.err_none"))
 	}
 	if len(out) > 25 {
-		return nil, fmt.Errorf("лимит пула: 25 вопросов")
+		return nil, errors.New(tr(ctx, "pool.err_too_many"))
 	}
 	return out, nil
 }
 
+func poolDraft(ctx context.Context, line int, question, answer, category, difficulty string) (schema.QuestionDraft, error) {
+	q := strings.TrimSpace(question)
+	a := strings.TrimSpace(answer)
+	if q == "" || a == "" {
+		return schema.QuestionDraft{}, errors.New(tr(ctx, "pool.err_empty", line))
+	}
+	if utf8.RuneCountInString(q) > 250 || utf8.RuneCountInString(a) > 250 {
+		return schema.QuestionDraft{}, errors.New(tr(ctx, "pool.err_limit", line))
+	}
+	draft := schema.QuestionDraft{QuestionText: q, AnswerText: a, Category: schema.CategoryOther, Difficulty: schema.DifficultyMedium}
+	if strings.TrimSpace(category) != "" {
+		c, ok := parseCategory(category)
+		if !ok {
+			return schema.QuestionDraft{}, errors.New(tr(ctx, "pool.err_category", line, shortText(category, 40)))
+		}
+		draft.Category = c
+	}
+	if strings.TrimSpace(difficulty) != "" {
+		d, ok := parseDifficulty(difficulty)
+		if !ok {
+			return schema.QuestionDraft{}, errors.New(tr(ctx, "pool.err_difficulty", line, shortText(difficulty, 40)))
+		}
+		draft.Difficulty = d
+	}
+	return draft, nil
+}
+
+func parseLanguage(raw string) (schema.Language, bool) {
+	v := strings.ToLower(strings.TrimSpace(raw))
+	for _, lang := range schema.Languages {
+		if v == string(lang) || matchesTitle(v, languageKeys[lang]) {
+			return lang, true
+		}
+	}
+	return "", false
+}
+
 func (c *Controller) answerCallback(ctx context.Context, callbackID, text string, showAlert bool) {
 	_, _ = c.bot.AnswerCallbackQuery(ctx, &tgbot.AnswerCallbackQueryParams{
 		CallbackQueryID: callbackID,
@@ -138,3 +271,14 @@ func (c *Controller) answerCallback(ctx context.Context, callbackID, text string
 		ShowAlert:       showAlert,
 	})
 }
+
+func broadcastAudienceTitle(ctx context.Context, draft schema.BroadcastDraft) string {
+	switch draft.Audience {
+	case schema.BroadcastAudienceActive:
+		return tr(ctx, "broadcast.audience_active", trn(ctx, "plural.days", draft.ActiveDays))
+	case schema.BroadcastAudienceTeamOwners:
+		return tr(ctx, "broadcast.audience_owners")
+	default:
+		return tr(ctx, "broadcast.audience_all")
+	}
+}
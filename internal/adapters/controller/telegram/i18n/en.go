@@ -0,0 +1,568 @@
+package i18n
+
+var en = map[string]string{
+	"admin.add":                        "➕ Add a question",
+	"admin.moderation":                 "🛡 Moderation",
+	"admin.moderation_count":           "🛡 Moderation (%d)",
+	"admin.my_questions":               "📋 My questions",
+	"admin.pool":                       "📥 Add a pool of questions",
+	"admin.reports":                    "🚩 Reports",
+	"admin.reports_count":              "🚩 Reports (%d)",
+	"admin.title":                      "Admin panel",
+	"audit.delete":                     "🗑 deleted the question",
+	"audit.edit":                       "✏️ edited the question",
+	"audit.empty":                      "📋 The action log is empty.",
+	"audit.entry":                      "%s · %s\n%s",
+	"audit.question":                   "Question: %s",
+	"audit.question_empty":             "📋 No actions on this question yet.",
+	"audit.question_purged":            "deleted forever",
+	"audit.question_title":             "📋 Actions on this question (%d):",
+	"audit.reassign":                   "👤 reassigned the question: %s → %s",
+	"audit.title":                      "📋 Question action log:",
+	"broadcast.audience_30_button":     "🕒 Last 30 days",
+	"broadcast.audience_7_button":      "🕒 Active in the last 7 days",
+	"broadcast.audience_active":        "active in the last %s",
+	"broadcast.audience_all":           "all users",
+	"broadcast.audience_all_button":    "👥 All users",
+	"broadcast.audience_n_button":      "🕒 Active in the last N days…",
+	"broadcast.audience_owners":        "team owners",
+	"broadcast.audience_owners_button": "👑 Team owners",
+	"broadcast.audience_prompt":        "Who should receive the broadcast?",
+	"broadcast.button":                 "📣 Broadcasts",
+	"broadcast.choose_audience_first":  "Choose an audience first",
+	"broadcast.days_invalid":           "Enter a number from 1 to %d",
+	"broadcast.days_prompt":            "How many recent days of activity should count? Enter a number from 1 to %d",
+	"broadcast.edit_audience":          "👥 Change audience",
+	"broadcast.edit_text":              "✏️ Edit text",
+	"broadcast.empty":                  "No broadcasts yet",
+	"broadcast.invalid":                "Check the broadcast text and audience",
+	"broadcast.launch_failed":          "Failed to start the broadcast",
+	"broadcast.launched":               "🚀 Broadcast started\nRecipients: %s\n\nYou will get the results in a message; progress is shown under «📣 Broadcasts»",
+	"broadcast.legend":                 "📬 delivered · 🚫 blocked the bot · ⚠️ errors",
+	"broadcast.new":                    "✍️ New broadcast",
+	"broadcast.no_recipients":          "No recipients for the selected audience",
+	"broadcast.preview":                "Broadcast preview\nAudience: %s\nRecipients: %s\n\n——————\n%s",
+	"broadcast.recent":                 "Recent broadcasts:",
+	"broadcast.report":                 "📣 Broadcast finished\nRecipients: %s\nDelivered: %s\nBlocked the bot: %s\nErrors: %s",
+	"broadcast.send":                   "🚀 Send",
+	"broadcast.text_empty":             "The broadcast text cannot be empty",
+	"broadcast.text_prompt":            "Send the broadcast text (up to %d characters)\nCancel: /stop",
+	"broadcast.text_too_long":          "The broadcast text must not exceed %d characters",
+	"broadcast.title":                  "Broadcasts",
+	"category.geography":               "Geography",
+	"category.history":                 "History",
+	"category.literature":              "Literature",
+	"category.movies":                  "Movies",
+	"category.music":                   "Music",
+	"category.other":                   "Other",
+	"category.science":                 "Science",
+	"category.sport":                   "Sport",
+	"common.back":                      "⬅ Back",
+	"common.back_plain":                "Back",
+	"common.back_to_list":              "⬅ Back to list",
+	"common.cancel":                    "❌ Cancel",
+	"common.confirm":                   "✅ Confirm",
+	"common.delete":                    "🗑 Delete",
+	"common.edit":                      "✏️ Edit",
+	"common.error":                     "Something went wrong. Please try again later",
+	"common.forbidden":                 "Not enough permissions",
+	"common.form_expired":              "This form has expired, please start over",
+	"common.next":                      "➡️ Next",
+	"common.no":                        "❌ No",
+	"common.no_name":                   "No name",
+	"common.none":                      "none",
+	"common.open":                      "Open",
+	"common.page":                      "Page %d/%d",
+	"common.prev":                      "⬅️ Prev",
+	"common.refresh":                   "🔄 Refresh",
+	"common.save":                      "✅ Save",
+	"common.use_buttons":               "Please use the buttons below the message",
+	"common.use_menu":                  "Use /menu",
+	"daily.answer":                     "\n\nAnswer: ",
+	"daily.disabled":                   "Question of the day turned off",
+	"daily.enabled":                    "The question of the day will arrive every day at ",
+	"daily.header":                     "📅 Question of the day\n",
+	"daily.knew_no":                    "❌ I didn't",
+	"daily.knew_prompt":                "\n\nDid you know the answer?",
+	"daily.knew_yes":                   "✅ I knew it",
+	"daily.off_button":                 "🔕 Don't send the question of the day",
+	"daily.on_button":                  "📅 Get the question of the day",
+	"daily.profile_note":               "\nQuestion of the day: every day at ",
+	"daily.question":                   "📅 Question of the day\n%s",
+	"daily.show_answer":                "👀 Show answer",
+	"daily.summary":                    "Results of yesterday's question\nQuestion: %s\nAnswer: %s\n",
+	"daily.summary_knew":               "Knew the answer: %s of %s\n\n",
+	"daily.summary_nobody":             "Nobody said whether they knew the answer\n\n",
+	"daily.thanks":                     "\n\nThanks! Results come tomorrow with the next question",
+	"daily.unavailable":                "The question of the day is unavailable",
+	"difficulty.easy":                  "Easy",
+	"difficulty.hard":                  "Hard",
+	"difficulty.medium":                "Medium",
+	"difficulty.mixed":                 "Mixed",
+	"duplicate.admin_button":           "🧬 Similar questions",
+	"duplicate.card":                   "Question: %s\nAnswer: %s\nAuthor: %s\nStatus: %s\nCategory: %s\nDifficulty: %s\nLanguage: %s\nAdded: %s",
+	"duplicate.cluster":                "🧬 Similar questions group %d of %d (up to %d%% match)",
+	"duplicate.cluster_item":           "%d. %s\nAnswer: %s\nAuthor: %s · %s",
+	"duplicate.match":                  "\n%d. “%s” — %s, %d%% match",
+	"duplicate.none":                   "No similar questions found.",
+	"duplicate.open_own":               "📂 Open in “My questions”",
+	"duplicate.save_anyway":            "💾 Save anyway",
+	"duplicate.save_prompt":            "\n\nSave the question anyway?",
+	"duplicate.warning":                "⚠️ Similar questions already exist:",
+	"duration.hours_minutes":           "%d h %d min",
+	"duration.less_minute":             "less than a minute",
+	"duration.minutes":                 "%d min",
+	"export.button":                    "📤 Export",
+	"export.caption":                   "Export: %s",
+	"export.failed":                    "Failed to export the questions",
+	"export.preparing":                 "Preparing the file…",
+	"export.prompt":                    "Choose the file format.\nThe file contains all your questions (except deleted ones) with status, creation and update dates, and statistics: how many times the question was played and guessed, ratings and reports.\nThe CSV can be opened in a spreadsheet, edited and uploaded back via «📥 Add a pool of questions».",
+	"field.answer":                     "Answer",
+	"field.category":                   "Category",
+	"field.difficulty":                 "Difficulty",
+	"field.language":                   "Language",
+	"field.question":                   "Question",
+	"form.answer_empty":                "The answer cannot be empty",
+	"form.answer_prompt":               "Write the answer",
+	"form.answer_too_long":             "The answer must not exceed 250 characters",
+	"form.choose_category":             "Choose a category",
+	"form.choose_difficulty":           "Choose a difficulty",
+	"form.choose_field":                "What should be changed?",
+	"form.choose_language":             "Choose the question language",
+	"form.created":                     "✅ Question added",
+	"form.enter_answer":                "Enter the answer",
+	"form.enter_question":              "Enter the question",
+	"form.limit":                       "Limit is 250 characters for the question and the answer",
+	"form.new_answer_prompt":           "Enter the new answer text",
+	"form.new_question_prompt":         "Enter the new question text",
+	"form.preview":                     "Preview\n\nQuestion: %s\nAnswer: %s\nCategory: %s\nDifficulty: %s\nLanguage: %s",
+	"form.question_empty":              "The question cannot be empty",
+	"form.question_prompt":             "Write the question",
+	"form.question_too_long":           "The question must not exceed 250 characters",
+	"form.save_failed":                 "Failed to save the question",
+	"form.text_empty":                  "The question and the answer cannot be empty",
+	"form.translation_prompt":          "Translation into %s\nWrite the question",
+	"form.update_failed":               "Failed to update the question",
+	"form.updated":                     "✅ Updated",
+	"game.end_button":                  "🏁 End game",
+	"game.next":                        "Next question",
+	"game.no_new":                      "No new questions",
+	"game.question_prefix":             "Question:\n",
+	"game.show_answer":                 "Show answer",
+	"get.bad_id":                       "Invalid id",
+	"get.failed":                       "Failed to look up the user",
+	"get.info":                         "id: %d\nname: %s\nusername: %s\nlanguage: %s\nis_bot: %t\nlast interaction: %s",
+	"get.not_found":                    "The user has not pressed /start or was not found",
+	"get.usage":                        "Usage: /get <id>",
+	"group.already_host":               "You are already the host",
+	"group.answer_host_only":           "Only the host can see the answer",
+	"group.ask_host_only":              "Only the host can ask questions",
+	"group.endgame_host_only":          "Only the host can end the game",
+	"group.help_endgame":               "/endgame - end the game and show the results (host only)",
+	"group.help_games":                 "/games - recent games in this chat",
+	"group.help_host":                  "/host - become the host (a chat administrator can take the role from the current host)",
+	"group.help_newgame":               "/newgame [rounds|duration] - start a new game, e.g. /newgame 20 or /newgame 45m (host only)",
+	"group.help_note":                  "Only the host sees the answer. Everyone sees it once the host taps «Reveal to all».",
+	"group.help_passhost":              "/passhost - pass the host role (as a reply to a player's message)",
+	"group.help_play":                  "/play - ask a question (host only)",
+	"group.help_title":                 "Playing in a group:",
+	"group.help_unhost":                "/unhost - stop being the host (a chat administrator can clear the current host)",
+	"group.host_failed":                "Failed to assign the host",
+	"group.host_only":                  "Only the host can do this",
+	"group.host_set":                   "Host: %s\nOnly the host sees the answers. Start: /play",
+	"group.host_taken":                 "The host is already set: %s\nThey can hand the role over with /passhost in reply to a player's message, or a chat administrator can take it with /host",
+	"group.host_taken_over":            "Chat administrator %s is now the host instead of %s\nOnly the host sees the answers. Start: /play",
+	"group.need_host":                  "Assign a host first: /host",
+	"group.new_host":                   "New host: ",
+	"group.newgame_host_only":          "Only the host can start a game. Become the host: /host",
+	"group.next":                       "➡️ Next question",
+	"group.passhost_failed":            "Failed to pass the host role",
+	"group.passhost_host_only":         "Only the host can pass the role",
+	"group.passhost_usage":             "Send /passhost as a reply to the new host's message",
+	"group.peek":                       "🔒 Answer (host only)",
+	"group.question":                   "Question:\n%s\n\nHost: %s",
+	"group.reset_done":                 "🔄 All questions are new again for this chat",
+	"group.reset_note":                 "\nThe host can start over: all questions will become new again for this chat.",
+	"group.reveal":                     "📣 Reveal to all",
+	"group.revealed":                   "Question:\n%s\n\nAnswer: %s",
+	"group.unhost_failed":              "Failed to remove the host role",
+	"group.unhost_host_only":           "Only the host or a chat administrator can clear the host role",
+	"group.unhosted":                   "There is no host now. Become the host: /host",
+	"help.admin":                       "/admin - admin panel",
+	"help.endgame":                     "/endgame - end the game and show the results",
+	"help.games":                       "/games - recent games",
+	"help.get":                         "/get <id> - user info",
+	"help.grant":                       "/grant <id> <role> - grant a role",
+	"help.help":                        "/help - list of commands",
+	"help.jointeam":                    "/jointeam <uuid> - join a team",
+	"help.menu":                        "/menu - main menu",
+	"help.newgame":                     "/newgame [rounds|duration] - new game with a limit, e.g. /newgame 20 or /newgame 45m",
+	"help.play":                        "/play - start playing",
+	"help.profile":                     "/profile - your profile",
+	"help.revoke":                      "/revoke <id> - revoke a role",
+	"help.roles":                       "/roles - user roles",
+	"help.stop":                        "/stop - stop the current form/pool",
+	"help.suggest":                     "/suggest - suggest your own question",
+	"help.team":                        "/team - team menu",
+	"help.title":                       "Available commands:",
+	"history.back_to_question":         "⬅ Back to question",
+	"history.button":                   "📜 History",
+	"history.current":                  " (current)",
+	"history.field_answer":             "Answer: %s",
+	"history.field_category":           "Category: %s → %s",
+	"history.field_difficulty":         "Difficulty: %s → %s",
+	"history.field_language":           "Language: %s → %s",
+	"history.field_question":           "Question: %s",
+	"history.no_changes":               "No changes",
+	"history.original":                 "\nOriginal version\nQuestion: %s\nAnswer: %s",
+	"history.restore_button":           "↩️ v%d",
+	"history.restore_failed":           "Failed to restore the version",
+	"history.restored":                 "Version v%d restored",
+	"history.revision":                 "v%d · %s · %s",
+	"history.title":                    "📜 Question history — versions: %d\n[-removed-] {+added+}",
+	"import.all_button":                "✅ Import all valid (%s)",
+	"import.choose":                    "\n\nImport all valid questions at once or review them one by one?",
+	"import.done":                      "Import finished. Questions added: %s",
+	"import.download_failed":           "Failed to download the file, please try again",
+	"import.duplicate_item":            "• “%s” ≈ “%s” (%d%%)",
+	"import.duplicates":                "Similar to existing questions or repeated in the file: %s",
+	"import.err_csv":                   "line %d: malformed CSV",
+	"import.err_json":                  "line %d: malformed JSON",
+	"import.err_json_array":            "expected a JSON array of objects",
+	"import.err_json_row":              "line %d: fields must be strings",
+	"import.err_language":              "line %d: unknown language %q",
+	"import.errors_file":               "Full list of errors",
+	"import.failed":                    "Failed to import the questions",
+	"import.more_duplicates":           "…and %s more",
+	"import.more_errors":               "…and %s more",
+	"import.new_button":                "🆕 Import only new (%s)",
+	"import.nothing":                   "\n\nNothing to import. Fix the file and send it again.",
+	"import.step_button":               "👀 Review one by one",
+	"import.summary":                   "File: %s\nValid questions: %s\nRows with errors: %s",
+	"import.too_large":                 "The file is too large: %d MB max",
+	"import.too_many":                  "The file has more than %s questions, please split it",
+	"import.unsupported":               "Supported files: .csv, .json and .txt",
+	"label.answer":                     "Answer: ",
+	"label.author":                     "Author: ",
+	"label.categories":                 "Categories: ",
+	"label.category":                   "Category: ",
+	"label.difficulty":                 "Difficulty: ",
+	"label.language":                   "Language: ",
+	"label.packs":                      "Packs: ",
+	"label.question":                   "Question: ",
+	"lang.auto":                        "same as Telegram",
+	"lang.auto_button":                 "🔄 Same as Telegram",
+	"lang.button":                      "🌐 Language",
+	"lang.en":                          "English",
+	"lang.en_button":                   "🇬🇧 English",
+	"lang.failed":                      "Failed to save the language",
+	"lang.prompt":                      "Choose the bot language\nCurrent: %s",
+	"lang.ru":                          "Russian",
+	"lang.ru_button":                   "🇷🇺 Русский",
+	"lang.saved":                       "Language saved",
+	"layout.datetime":                  "Jan 2, 2006 15:04",
+	"layout.datetime_short":            "Jan 2 15:04",
+	"log.new_user":                     "New user:\n%s",
+	"manage.admin_button":              "🛠 All questions",
+	"manage.all_authors":               "👥 All authors",
+	"manage.audit_button":              "📋 Action log",
+	"manage.authors_empty":             "There are no questions yet.",
+	"manage.authors_title":             "👤 Choose an author (number of questions shown):",
+	"manage.by_author":                 "👤 By author",
+	"manage.card":                      "Question: %s\nAnswer: %s\nAuthor: %s (id=%d)\nStatus: %s\nCategory: %s\nDifficulty: %s\nLanguage: %s\nRating: 👍 %d · 👎 %d\nAdded: %s\nUpdated: %s",
+	"manage.delete_button":             "🗑 Delete",
+	"manage.delete_confirm":            "Delete this question? It will disappear for all players and move to your trash, and the action will be recorded in the log.",
+	"manage.deleted":                   "Question deleted and moved to your trash",
+	"manage.question_audit_button":     "📋 Question log",
+	"manage.reassign_button":           "👤 Reassign to another author",
+	"manage.reassign_prompt":           "Send the Telegram ID of the new author (a number). The user must have started the bot at least once.",
+	"manage.reassign_same_author":      "This user already owns the question. Send another ID.",
+	"manage.reassign_unknown_user":     "No user with this ID has started the bot yet. Send another ID.",
+	"manage.reassigned":                "Question reassigned to %s",
+	"manage.title":                     "🛠 All questions — %s. Here you can edit, delete and reassign any question; every action is recorded in the log.",
+	"manage.title_author":              "🛠 Questions by %s (id=%d) — %s.",
+	"menu.admin":                       "Admin",
+	"menu.play":                        "Play",
+	"menu.profile":                     "Profile",
+	"menu.suggest":                     "💡 Suggest a question",
+	"menu.team":                        "Team",
+	"menu.title":                       "Main menu",
+	"moderation.already_processed":     "This question has already been processed",
+	"moderation.approve":               "✅ Approve",
+	"moderation.approve_failed":        "Failed to approve the question",
+	"moderation.approved":              "Question approved",
+	"moderation.approved_author":       "✅ Your question was approved and added to the game\n\nQuestion: ",
+	"moderation.card":                  "Suggested question\nAuthor: %s\nSubmitted: %s\n\nQuestion: %s\nAnswer: %s\nCategory: %s\nDifficulty: %s\nLanguage: %s",
+	"moderation.empty":                 "Moderation\n\nNo new suggested questions",
+	"moderation.list":                  "Moderation\nAwaiting review: %s",
+	"moderation.new":                   "🛡 New question for moderation from %s\n\nQuestion: %s",
+	"moderation.reason_empty":          "The reason cannot be empty",
+	"moderation.reason_too_long":       "The reason must not exceed 500 characters",
+	"moderation.reject":                "❌ Reject",
+	"moderation.reject_failed":         "Failed to reject the question",
+	"moderation.reject_prompt":         "Write the rejection reason — the author will receive it\nCancel: /stop",
+	"moderation.rejected":              "Question rejected, the author received the reason",
+	"moderation.rejected_author":       "❌ Your question was rejected\n\nQuestion: %s\nReason: %s",
+	"pack.admin_button":                "📦 Question packs",
+	"pack.card":                        "📦 %s\nIn the pack: %s",
+	"pack.create_failed":               "Failed to create the pack",
+	"pack.delete_confirm":              "Delete the pack? Only an empty pack can be deleted.",
+	"pack.delete_failed":               "Failed to delete the pack",
+	"pack.delete_not_empty":            "The pack still has questions — delete them and purge them from the trash first",
+	"pack.delete_yes":                  "🗑 Yes, delete",
+	"pack.deleted":                     "Pack deleted",
+	"pack.description_prompt":          "Enter the pack description (up to %d characters) — players see it when choosing packs",
+	"pack.description_too_long":        "The description is longer than %d characters",
+	"pack.list":                        "Question packs\nPack questions are kept out of the general pool: players get them only after enabling the pack in the «Game» menu.",
+	"pack.list_empty":                  "No packs yet.\nA pack is a separate collection of questions (e.g. «New Year» or «Kids») that players enable in the «Game» menu.",
+	"pack.new_button":                  "➕ New pack",
+	"pack.pool_button":                 "📥 Add questions to the pack",
+	"pack.pool_target":                 "Questions will be added to the «%s» pack.",
+	"pack.skip_description":            "⏭ No description",
+	"pack.title_prompt":                "Enter the pack title (up to %d characters)",
+	"pack.title_too_long":              "The title is longer than %d characters",
+	"pack.unavailable":                 "Pack not found",
+	"play.all":                         "all",
+	"play.all_categories":              "🔄 All categories",
+	"play.categories_button":           "🗂 Categories",
+	"play.categories_owner_note":       "\n\nOnly the team owner can change the categories",
+	"play.categories_owner_only":       "Only the team owner can change the categories",
+	"play.categories_prompt":           "Choose categories for the game.\nIf nothing is selected, questions come from all categories.",
+	"play.categories_save_failed":      "Failed to save the categories",
+	"play.difficulty_button":           "🎚 Difficulty",
+	"play.difficulty_owner_note":       "\n\nOnly the team owner can change the difficulty",
+	"play.difficulty_owner_only":       "Only the team owner can change the difficulty",
+	"play.difficulty_prompt":           "Choose the question difficulty.\nMixed mode alternates easy, medium and hard questions.",
+	"play.difficulty_save_failed":      "Failed to save the difficulty",
+	"play.exhausted":                   "No new questions — you have played every matching question 🎉\n\nYou can start over: your viewing history will be archived and stats kept. Or change the categories and difficulty.",
+	"play.fallback_off":                "⬜ When questions run out — use other languages",
+	"play.fallback_on":                 "✅ When questions run out — use other languages",
+	"play.language_auto":               "interface language (%s)",
+	"play.language_auto_button":        "🔄 Interface language",
+	"play.language_button":             "🌐 Question language",
+	"play.language_owner_note":         "\n\nOnly the team owner can change the question language",
+	"play.language_owner_only":         "Only the team owner can change the question language",
+	"play.language_prompt":             "Choose the question language.\nBy default questions are asked in the interface language.",
+	"play.language_save_failed":        "Failed to save the question language",
+	"play.menu":                        "Game\nCategories: %s\nDifficulty: %s\nQuestion language: %s",
+	"play.packs_button":                "📦 Question packs",
+	"play.packs_empty":                 "There are no packs yet, questions come from the general pool.",
+	"play.packs_general":               "general pool",
+	"play.packs_general_button":        "🗂 General pool only",
+	"play.packs_owner_note":            "\n\nOnly the team owner can change packs",
+	"play.packs_owner_only":            "Only the team owner can change packs",
+	"play.packs_prompt":                "Choose question packs.\nIf at least one pack is enabled, questions come only from enabled packs; otherwise from the general pool.",
+	"play.packs_save_failed":           "Failed to save packs",
+	"play.reset_button":                "🔄 Start over",
+	"play.reset_confirm_team":          "Start over? All questions will be new for the whole team again.",
+	"play.reset_confirm_user":          "Start over? All questions will be new for you again.",
+	"play.reset_done":                  "Progress reset, archived questions: %s",
+	"play.reset_failed":                "Failed to reset progress",
+	"play.reset_note":                  "\nYour viewing history will be archived; stats are kept.",
+	"play.reset_owner_only":            "Only the team owner can start over",
+	"play.reset_yes":                   "✅ Yes, start over",
+	"play.settings_button":             "⚙️ Game settings",
+	"play.start":                       "▶️ Start",
+	"play.team_settings":               "\nSettings are shared by the whole team",
+	"pool.done":                        "Pool finished. Added: %d of %d",
+	"pool.err_category":                "line %d: unknown category %q",
+	"pool.err_difficulty":              "line %d: unknown difficulty %q",
+	"pool.err_empty":                   "line %d: question and answer cannot be empty",
+	"pool.err_format":                  "line %d: expected format [question]-[answer]",
+	"pool.err_limit":                   "line %d: limit is 250 characters for the question and the answer",
+	"pool.err_none":                    "no questions found",
+	"pool.err_too_many":                "pool limit: 25 questions",
+	"pool.parse_error":                 "Parse error: ",
+	"pool.preview":                     "Question pool (%d/%d)\n\nQuestion: %s\nAnswer: %s\nCategory: %s\nDifficulty: %s\nLanguage: %s\n\nAdd this question?",
+	"pool.prompt":                      "Send a pool of questions (up to 25) in this format:\n[2+2]-[4]\n[4+2]-[6]-[science]-[easy]\n\nCategory and difficulty in the third and fourth brackets are optional; defaults are «Other» and «Medium».\n\nLarger sets can be uploaded as a file (up to 5,000 questions):\n• .txt — lines in the same format;\n• .csv — columns «question», «answer», «category», «difficulty», «language» (the header is optional, comma or semicolon separated);\n• .json — an array of objects with question, answer, category, difficulty and language fields.\nTo stop: /stop",
+	"pool.stopped":                     "Pool stopped. Added: %d of %d",
+	"profile.language":                 "\nLanguage: %s",
+	"profile.load_failed":              "Failed to load the profile",
+	"profile.text":                     "Profile\nName: %s\nUsername: %s\nAnswers revealed: %s\nTeam points: %s\nDays in the game: %s\nID: %d",
+	"profile.unavailable":              "Profile unavailable. Press /start",
+	"question.card":                    "Question: %s\nCategory: %s\nDifficulty: %s\nLanguage: %s\nRating: 👍 %d · 👎 %d",
+	"question.delete_confirm":          "Delete this question? It will disappear for all players and go to the trash, where you can restore it.",
+	"question.delete_failed":           "Failed to delete the question",
+	"question.delete_no":               "❌ No, cancel",
+	"question.delete_own_only":         "You can only delete your own questions",
+	"question.delete_yes":              "✅ Yes, delete",
+	"question.deleted":                 "Moved to trash",
+	"question.edit_own_only":           "You can only edit your own questions",
+	"question.not_yours":               "This is not your question",
+	"question.show_answer":             "👁 Show answer",
+	"question.status_note":             "\nStatus: %s%s — not shown in the game until a moderator reviews it",
+	"question.translate":               "🌐 Add translation: %s",
+	"question.translation_exists":      "A translation into this language already exists",
+	"question.translations":            "\nTranslations: %s",
+	"question.unavailable":             "This question is no longer available",
+	"questions.empty":                  "My questions\n\nNo questions added yet",
+	"questions.legend":                 "\n⏳ — awaiting moderation, 🚫 — hidden after reports",
+	"questions.title":                  "My questions",
+	"rating.failed":                    "Failed to save your rating",
+	"rating.own":                       "You can't rate your own question",
+	"rating.prompt":                    "How did you like the question?",
+	"rating.thanks":                    "Thanks for rating",
+	"rating.thanks_with":               "Thanks for rating ",
+	"reason.duplicate":                 "Duplicate",
+	"reason.offensive":                 "Offensive",
+	"reason.other":                     "Other",
+	"reason.wrong":                     "Wrong answer",
+	"report.already":                   "You have already reported this question",
+	"report.button":                    "⚠️ Report",
+	"report.cancelled":                 "Report cancelled",
+	"report.failed":                    "Failed to send the report",
+	"report.hidden_note":               "\nThe question is hidden until reviewed",
+	"report.prompt":                    "What's wrong with the question?",
+	"report.sent":                      "Thanks! The report was sent to the admins",
+	"report.unknown_reason":            "Unknown reason",
+	"review.closed":                    "Reports on this question are already closed",
+	"review.count":                     "Reports: %d",
+	"review.deactivate":                "🚫 Deactivate",
+	"review.deactivate_failed":         "Failed to deactivate the question",
+	"review.deactivated":               "Question deactivated",
+	"review.dismiss":                   "✅ Dismiss reports",
+	"review.dismiss_failed":            "Failed to close the reports",
+	"review.dismissed":                 "Reports dismissed, the question is back in the game",
+	"review.empty":                     "Reports\n\nNo open reports",
+	"review.hidden":                    "The question is hidden until reviewed",
+	"review.list":                      "Reports\nQuestions with open reports: %s\n🙈 — hidden until reviewed",
+	"review.updated":                   "✅ Updated, reports closed",
+	"role.admin":                       "🛡 admin",
+	"role.author":                      "✍️ author",
+	"role.moderator":                   "🔎 moderator",
+	"role.owner":                       "👑 owner",
+	"roles.admin_button":               "👥 Roles",
+	"roles.bootstrap":                  " · from ADMIN_IDS",
+	"roles.grant_usage":                "Usage: /grant <id> <owner|admin|moderator|author>",
+	"roles.granted":                    "%s now has the %s role",
+	"roles.granted_user":               "You have been granted the %s role. Open /admin to see the available sections.",
+	"roles.help":                       "\nGrant a role: /grant <id> <owner|admin|moderator|author>\nRevoke a role: /revoke <id>\n\nAn owner manages roles; an admin moderates, sends broadcasts and edits any question; a moderator adds questions and moderates; an author adds and edits their own questions.",
+	"roles.member":                     "• %s (id=%d) — %s",
+	"roles.no_role":                    "This user has no role.",
+	"roles.protected":                  "You cannot change your own role or the roles of owners listed in ADMIN_IDS.",
+	"roles.revoke_button":              "❌ Revoke role: %s",
+	"roles.revoke_confirm":             "Revoke the %[2]s role from %[1]s?",
+	"roles.revoke_failed":              "Failed to revoke the role",
+	"roles.revoke_usage":               "Usage: /revoke <id>",
+	"roles.revoke_yes":                 "✅ Yes, revoke",
+	"roles.revoked":                    "Role of %s revoked",
+	"roles.revoked_user":               "Your role in the bot has been revoked.",
+	"roles.title":                      "👥 User roles:",
+	"score.already":                    "A point for this question has already been given",
+	"score.failed":                     "Failed to save the point",
+	"score.nobody":                     "Nobody guessed it",
+	"score.nobody_button":              "🙅 Nobody",
+	"score.prompt":                     "Who guessed it?",
+	"score.winner":                     "Point goes to: ",
+	"search.again":                     "\n\nSend another query to search again.",
+	"search.button":                    "🔍 Search",
+	"search.empty":                     "🔍 “%s”\nNothing found.",
+	"search.prompt":                    "Enter a word or phrase (up to %d characters) to search your questions and answers. Word forms are matched; a phrase in quotes is matched as a whole and a word with a minus is excluded.",
+	"search.results":                   "🔍 “%s”\nFound: %s, best matches first.",
+	"search.too_long":                  "The query is too long: %d characters max",
+	"session.already_running":          "A game is already running. End it first: /endgame",
+	"session.answers_revealed":         "Answers revealed: %s",
+	"session.duration":                 "Duration: ",
+	"session.end_failed":               "Failed to end the game",
+	"session.ended":                    "🏁 Game over\n\n",
+	"session.game_from":                "Game of ",
+	"session.history_empty":            "No finished games yet",
+	"session.history_failed":           "Failed to load the game history",
+	"session.history_title":            "Recent games:",
+	"session.limit_reached":            "🏁 Game limit reached\n\n",
+	"session.new_hint":                 "\n\nNew game: /newgame",
+	"session.newgame_usage":            "Usage: /newgame [rounds|duration]\nFor example: /newgame 20 or /newgame 45m",
+	"session.no_limit":                 "no limit",
+	"session.none":                     "There is no active game right now",
+	"session.rounds_played":            "Questions played: %s",
+	"session.scores":                   "Points:",
+	"session.start_failed":             "Failed to start the game",
+	"session.started":                  "A new game has started\nLimit: %s\nEnd: /endgame",
+	"start.register_failed":            "Failed to register the user",
+	"start.welcome":                    "Welcome to Loud Question",
+	"status.active":                    "active",
+	"status.deleted":                   "deleted",
+	"status.draft":                     "pending moderation",
+	"status.hidden":                    "hidden after reports",
+	"status.rejected":                  "rejected",
+	"stop.done":                        "Stopped",
+	"stop.nothing":                     "Nothing to stop",
+	"sub.disabled":                     "New question notifications are off",
+	"sub.enabled":                      "We'll let you know when new questions appear 🔔",
+	"sub.new_questions":                "🔔 New questions are here — you can keep playing!",
+	"sub.notify_button":                "🔔 Notify me about new ones",
+	"sub.off_button":                   "🔕 Don't notify me about new questions",
+	"sub.on_button":                    "🔔 Notify me about new questions",
+	"sub.on_note":                      "\n🔔 We'll let you know when new questions appear",
+	"sub.play_button":                  "▶️ Play",
+	"suggest.failed":                   "Failed to submit the question",
+	"suggest.intro":                    "Write the question you want to suggest.\nOnce a moderator approves it, it will appear in the game.\nCancel: /stop",
+	"suggest.sent":                     "📨 Your question was sent for moderation. We will let you know once it is reviewed",
+	"suggest.submit":                   "📨 Send for moderation",
+	"suggest.too_many":                 "You already have %d questions awaiting moderation. Wait until they are reviewed",
+	"team.already_in_this":             "You are already in this team",
+	"team.already_member":              "You are already in a team",
+	"team.bad_uuid":                    "Invalid UUID format",
+	"team.copy_code":                   "📋 Copy the code",
+	"team.create":                      "Create a team",
+	"team.create_failed":               "Failed to create a team",
+	"team.full":                        "The team already has 10 players",
+	"team.in_other":                    "You are already in another team",
+	"team.invite":                      "🔗 Invite link",
+	"team.invite_link":                 "Join link:\n%s\n\nOr enter the code manually: %s",
+	"team.invite_text":                 "You've been invited to a Loud Question team!",
+	"team.join_failed":                 "Failed to join the team",
+	"team.join_first":                  "Join a team first",
+	"team.join_prompt":                 "Enter the code: /jointeam <uuid>",
+	"team.join_usage":                  "Usage: /jointeam <uuid>",
+	"team.join_uuid":                   "Join by UUID",
+	"team.joined":                      "You joined the team",
+	"team.kick":                        "Kick %d",
+	"team.kick_failed":                 "Failed to kick the player",
+	"team.kick_owner_only":             "Only the owner can kick players",
+	"team.kicked":                      "Player kicked",
+	"team.leave":                       "🚪 Leave the team",
+	"team.left":                        "You left the team",
+	"team.load_failed":                 "Failed to load the team",
+	"team.member_line":                 " | id=%d (%s) | points: %d",
+	"team.member_not_found":            "This player is not in the team",
+	"team.members":                     "👥 Members",
+	"team.members_failed":              "Failed to load the members",
+	"team.members_title":               "Team members:",
+	"team.no_others":                   "There are no other members in the team",
+	"team.not_found":                   "Team not found",
+	"team.not_member":                  "You are not in a team",
+	"team.owner_note":                  "\nYou are the team owner",
+	"team.player_not_found":            "Player not found",
+	"team.role_member":                 "member",
+	"team.role_owner":                  "owner",
+	"team.share":                       "📨 Share the invite",
+	"team.text":                        "Team\nUUID: %s\nQuestions played: %s\nTeam points: %s%s",
+	"team.transfer":                    "🔄 Transfer the team",
+	"team.transfer_failed":             "Failed to transfer ownership",
+	"team.transfer_owner_only":         "Only the owner can transfer the team",
+	"team.transfer_owner_only_full":    "Only the owner can transfer the team",
+	"team.transfer_prompt":             "Who should get the team?",
+	"team.transferred":                 "Ownership transferred",
+	"trash.admin_button":               "🗑 Trash",
+	"trash.card":                       "🗑 Deleted question\nQuestion: %s\nAnswer: %s\nCategory: %s\nDifficulty: %s\nLanguage: %s\nDeleted: %s",
+	"trash.empty":                      "🗑 The trash is empty.",
+	"trash.purge_at":                   "\nWill be deleted forever: %s",
+	"trash.purge_button":               "🔥 Delete forever",
+	"trash.purge_confirm":              "Delete the question forever? It cannot be restored; its edit history and statistics will be removed too.",
+	"trash.purge_failed":               "Failed to delete the question",
+	"trash.purge_yes":                  "🔥 Yes, delete forever",
+	"trash.purged":                     "Question deleted forever",
+	"trash.restore_button":             "♻️ Restore",
+	"trash.restore_failed":             "Failed to restore the question",
+	"trash.restored":                   "Question restored",
+	"trash.retention":                  "Questions are deleted forever %s after deletion.",
+	"trash.retention_off":              "Automatic trash cleanup is disabled.",
+	"trash.title":                      "🗑 Trash — questions you deleted. You can restore them or delete them forever.",
+}
+
+var enPlurals = map[string][]string{
+	"plural.questions": {"%s question", "%s questions"},
+	"plural.days":      {"%s day", "%s days"},
+	"plural.packs":     {"%s pack", "%s packs"},
+}
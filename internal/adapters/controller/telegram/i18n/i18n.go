@@ -0,0 +1,152 @@
+package i18n
+
+import (
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type Lang string
+
+const (
+	Russian Lang = "ru"
+	English Lang = "en"
+
+	Default = Russian
+)
+
+var Langs = []Lang{Russian, English}
+
+var catalogs = map[Lang]map[string]string{
+	Russian: ru,
+	English: en,
+}
+
+var plurals = map[Lang]map[string][]string{
+	Russian: ruPlurals,
+	English: enPlurals,
+}
+
+func Resolve(preferred, telegramCode string) Lang {
+	return Lang(schema.ResolveLanguage(schema.Language(preferred), telegramCode))
+}
+
+type Count int
+
+type Localizer struct {
+	lang Lang
+}
+
+func For(lang Lang) Localizer {
+	if catalogs[lang] == nil {
+		lang = Default
+	}
+	return Localizer{lang: lang}
+}
+
+func (l Localizer) Lang() Lang {
+	if l.lang == "" {
+		return Default
+	}
+	return l.lang
+}
+
+func (l Localizer) T(key string, args ...any) string {
+	msg := l.lookup(key)
+	if len(args) == 0 {
+		return msg
+	}
+	for i, a := range args {
+		if n, ok := a.(Count); ok {
+			args[i] = l.Num(int(n))
+		}
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+func (l Localizer) N(key string, n int) string {
+	forms := plurals[l.Lang()][key]
+	if forms == nil {
+		forms = plurals[Default][key]
+	}
+	if len(forms) == 0 {
+		return l.Num(n)
+	}
+	idx := pluralIndex(l.Lang(), n)
+	if idx >= len(forms) {
+		idx = len(forms) - 1
+	}
+	return fmt.Sprintf(forms[idx], l.Num(n))
+}
+
+func (l Localizer) Num(n int) string {
+	sep := ","
+	if l.Lang() == Russian {
+		sep = "\u00a0"
+	}
+	digits := strconv.Itoa(n)
+	sign := ""
+	if n < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	if len(digits) <= 3 {
+		return sign + digits
+	}
+	var b strings.Builder
+	head := len(digits) % 3
+	if head > 0 {
+		b.WriteString(digits[:head])
+	}
+	for i := head; i < len(digits); i += 3 {
+		if b.Len() > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(digits[i : i+3])
+	}
+	return sign + b.String()
+}
+
+func (l Localizer) lookup(key string) string {
+	if msg, ok := catalogs[l.Lang()][key]; ok {
+		return msg
+	}
+	if msg, ok := catalogs[Default][key]; ok {
+		return msg
+	}
+	return key
+}
+
+func pluralIndex(lang Lang, n int) int {
+	if n < 0 {
+		n = -n
+	}
+	if lang != Russian {
+		if n == 1 {
+			return 0
+		}
+		return 1
+	}
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return 0
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+		return 1
+	default:
+		return 2
+	}
+}
+
+type ctxKey struct{}
+
+func WithLocalizer(ctx context.Context, l Localizer) context.Context {
+	return context.WithValue(ctx, ctxKey{}, l)
+}
+
+func FromContext(ctx context.Context) Localizer {
+	if l, ok := ctx.Value(ctxKey{}).(Localizer); ok {
+		return l
+	}
+	return For(Default)
+}
@@ -0,0 +1,568 @@
+package i18n
+
+var ru = map[string]string{
+	"admin.add":                        "➕ Добавить вопрос",
+	"admin.moderation":                 "🛡 Модерация",
+	"admin.moderation_count":           "🛡 Модерация (%d)",
+	"admin.my_questions":               "📋 Мои вопросы",
+	"admin.pool":                       "📥 Добавить Пулл запросов",
+	"admin.reports":                    "🚩 Жалобы",
+	"admin.reports_count":              "🚩 Жалобы (%d)",
+	"admin.title":                      "Админ-панель",
+	"audit.delete":                     "🗑 удалил вопрос",
+	"audit.edit":                       "✏️ отредактировал вопрос",
+	"audit.empty":                      "📋 Журнал действий пока пуст.",
+	"audit.entry":                      "%s · %s\n%s",
+	"audit.question":                   "Вопрос: %s",
+	"audit.question_empty":             "📋 С этим вопросом ещё ничего не делали.",
+	"audit.question_purged":            "удалён навсегда",
+	"audit.question_title":             "📋 Действия с вопросом (%d):",
+	"audit.reassign":                   "👤 передал вопрос: %s → %s",
+	"audit.title":                      "📋 Журнал действий с вопросами:",
+	"broadcast.audience_30_button":     "🕒 За 30 дней",
+	"broadcast.audience_7_button":      "🕒 Активные за 7 дней",
+	"broadcast.audience_active":        "активные за %s",
+	"broadcast.audience_all":           "все пользователи",
+	"broadcast.audience_all_button":    "👥 Все пользователи",
+	"broadcast.audience_n_button":      "🕒 Активные за N дней…",
+	"broadcast.audience_owners":        "создатели команд",
+	"broadcast.audience_owners_button": "👑 Создатели команд",
+	"broadcast.audience_prompt":        "Кому отправить рассылку?",
+	"broadcast.button":                 "📣 Рассылка",
+	"broadcast.choose_audience_first":  "Сначала выберите аудиторию",
+	"broadcast.days_invalid":           "Введите число от 1 до %d",
+	"broadcast.days_prompt":            "За сколько последних дней учитывать активность? Введите число от 1 до %d",
+	"broadcast.edit_audience":          "👥 Изменить аудиторию",
+	"broadcast.edit_text":              "✏️ Изменить текст",
+	"broadcast.empty":                  "Рассылок ещё не было",
+	"broadcast.invalid":                "Проверьте текст и аудиторию рассылки",
+	"broadcast.launch_failed":          "Не удалось запустить рассылку",
+	"broadcast.launched":               "🚀 Рассылка запущена\nПолучателей: %s\n\nИтоги придут сообщением, прогресс — в разделе «📣 Рассылка»",
+	"broadcast.legend":                 "📬 доставлено · 🚫 заблокировали бота · ⚠️ ошибки",
+	"broadcast.new":                    "✍️ Новая рассылка",
+	"broadcast.no_recipients":          "Нет получателей для выбранной аудитории",
+	"broadcast.preview":                "Предпросмотр рассылки\nАудитория: %s\nПолучателей: %s\n\n——————\n%s",
+	"broadcast.recent":                 "Последние рассылки:",
+	"broadcast.report":                 "📣 Рассылка завершена\nПолучателей: %s\nДоставлено: %s\nЗаблокировали бота: %s\nОшибки: %s",
+	"broadcast.send":                   "🚀 Отправить",
+	"broadcast.text_empty":             "Текст рассылки не может быть пустым",
+	"broadcast.text_prompt":            "Отправьте текст рассылки (до %d символов)\nОтмена: /stop",
+	"broadcast.text_too_long":          "Текст рассылки не должен быть длиннее %d символов",
+	"broadcast.title":                  "Рассылка",
+	"category.geography":               "География",
+	"category.history":                 "История",
+	"category.literature":              "Литература",
+	"category.movies":                  "Кино",
+	"category.music":                   "Музыка",
+	"category.other":                   "Разное",
+	"category.science":                 "Наука",
+	"category.sport":                   "Спорт",
+	"common.back":                      "⬅ Назад",
+	"common.back_plain":                "Назад",
+	"common.back_to_list":              "⬅ Назад к списку",
+	"common.cancel":                    "❌ Отмена",
+	"common.confirm":                   "✅ Подтвердить",
+	"common.delete":                    "🗑 Удалить",
+	"common.edit":                      "✏️ Изменить",
+	"common.error":                     "Ошибка. Попробуйте позже",
+	"common.forbidden":                 "Недостаточно прав",
+	"common.form_expired":              "Форма устарела, начните заново",
+	"common.next":                      "➡️ След",
+	"common.no":                        "❌ Нет",
+	"common.no_name":                   "Без имени",
+	"common.none":                      "нет",
+	"common.open":                      "Открыть",
+	"common.page":                      "Страница %d/%d",
+	"common.prev":                      "⬅️ Пред",
+	"common.refresh":                   "🔄 Обновить",
+	"common.save":                      "✅ Сохранить",
+	"common.use_buttons":               "Используйте кнопки под сообщением",
+	"common.use_menu":                  "Используйте /menu",
+	"daily.answer":                     "\n\nОтвет: ",
+	"daily.disabled":                   "Вопрос дня отключён",
+	"daily.enabled":                    "Вопрос дня будет приходить каждый день в ",
+	"daily.header":                     "📅 Вопрос дня\n",
+	"daily.knew_no":                    "❌ Не знал",
+	"daily.knew_prompt":                "\n\nЗнали ответ?",
+	"daily.knew_yes":                   "✅ Знал",
+	"daily.off_button":                 "🔕 Не получать вопрос дня",
+	"daily.on_button":                  "📅 Получать вопрос дня",
+	"daily.profile_note":               "\nВопрос дня: каждый день в ",
+	"daily.question":                   "📅 Вопрос дня\n%s",
+	"daily.show_answer":                "👀 Показать ответ",
+	"daily.summary":                    "Итоги вчерашнего вопроса\nВопрос: %s\nОтвет: %s\n",
+	"daily.summary_knew":               "Знали ответ: %s из %s\n\n",
+	"daily.summary_nobody":             "Никто не отметил, знал ли ответ\n\n",
+	"daily.thanks":                     "\n\nСпасибо! Итоги — завтра вместе со следующим вопросом",
+	"daily.unavailable":                "Вопрос дня недоступен",
+	"difficulty.easy":                  "Лёгкий",
+	"difficulty.hard":                  "Сложный",
+	"difficulty.medium":                "Средний",
+	"difficulty.mixed":                 "Смешанный",
+	"duplicate.admin_button":           "🧬 Похожие вопросы",
+	"duplicate.card":                   "Вопрос: %s\nОтвет: %s\nАвтор: %s\nСтатус: %s\nКатегория: %s\nСложность: %s\nЯзык: %s\nДобавлен: %s",
+	"duplicate.cluster":                "🧬 Группа похожих вопросов %d из %d (совпадение до %d%%)",
+	"duplicate.cluster_item":           "%d. %s\nОтвет: %s\nАвтор: %s · %s",
+	"duplicate.match":                  "\n%d. «%s» — %s, совпадение %d%%",
+	"duplicate.none":                   "Похожих вопросов в базе не найдено.",
+	"duplicate.open_own":               "📂 Открыть в «Моих вопросах»",
+	"duplicate.save_anyway":            "💾 Сохранить всё равно",
+	"duplicate.save_prompt":            "\n\nВсё равно сохранить вопрос?",
+	"duplicate.warning":                "⚠️ Похожие вопросы уже есть в базе:",
+	"duration.hours_minutes":           "%d ч %d мин",
+	"duration.less_minute":             "меньше минуты",
+	"duration.minutes":                 "%d мин",
+	"export.button":                    "📤 Экспорт",
+	"export.caption":                   "Экспорт: %s",
+	"export.failed":                    "Не удалось выгрузить вопросы",
+	"export.preparing":                 "Готовлю файл…",
+	"export.prompt":                    "Выберите формат файла.\nВ файл попадут все ваши вопросы (кроме удалённых) со статусом, датами создания и изменения и статистикой: сколько раз вопрос сыграли, сколько раз его угадали, оценки и жалобы.\nCSV можно открыть в таблице, поправить и загрузить обратно через «📥 Добавить Пулл запросов».",
+	"field.answer":                     "Ответ",
+	"field.category":                   "Категория",
+	"field.difficulty":                 "Сложность",
+	"field.language":                   "Язык",
+	"field.question":                   "Вопрос",
+	"form.answer_empty":                "Ответ не может быть пустым",
+	"form.answer_prompt":               "Напишите ответ",
+	"form.answer_too_long":             "Ответ не должен быть длиннее 250 символов",
+	"form.choose_category":             "Выберите категорию",
+	"form.choose_difficulty":           "Выберите сложность",
+	"form.choose_field":                "Что изменить?",
+	"form.choose_language":             "Выберите язык вопроса",
+	"form.created":                     "✅ Вопрос добавлен",
+	"form.enter_answer":                "Введите ответ",
+	"form.enter_question":              "Введите вопрос",
+	"form.limit":                       "Лимит 250 символов на вопрос и ответ",
+	"form.new_answer_prompt":           "Введите новый текст ответа",
+	"form.new_question_prompt":         "Введите новый текст вопроса",
+	"form.preview":                     "Предпросмотр\n\nВопрос: %s\nОтвет: %s\nКатегория: %s\nСложность: %s\nЯзык: %s",
+	"form.question_empty":              "Вопрос не может быть пустым",
+	"form.question_prompt":             "Напишите вопрос",
+	"form.question_too_long":           "Вопрос не должен быть длиннее 250 символов",
+	"form.save_failed":                 "Не удалось сохранить вопрос",
+	"form.text_empty":                  "Вопрос и ответ не могут быть пустыми",
+	"form.translation_prompt":          "Перевод на язык: %s\nНапишите вопрос",
+	"form.update_failed":               "Не удалось обновить вопрос",
+	"form.updated":                     "✅ Обновлено",
+	"game.end_button":                  "🏁 Завершить игру",
+	"game.next":                        "Следующий вопрос",
+	"game.no_new":                      "Нет новых вопросов",
+	"game.question_prefix":             "Вопрос:\n",
+	"game.show_answer":                 "Показать ответ",
+	"get.bad_id":                       "Некорректный id",
+	"get.failed":                       "Ошибка поиска пользователя",
+	"get.info":                         "id: %d\nимя: %s\nusername: %s\nязык: %s\nis_bot: %t\nпоследнее взаимодействие: %s",
+	"get.not_found":                    "Пользователь не нажимал /start или не найден",
+	"get.usage":                        "Использование: /get <id>",
+	"group.already_host":               "Вы уже ведущий",
+	"group.answer_host_only":           "Ответ видит только ведущий",
+	"group.ask_host_only":              "Задавать вопросы может только ведущий",
+	"group.endgame_host_only":          "Завершить игру может только ведущий",
+	"group.help_endgame":               "/endgame - завершить игру и показать итоги (только ведущий)",
+	"group.help_games":                 "/games - последние игры в этом чате",
+	"group.help_host":                  "/host - стать ведущим (администратор чата может забрать роль у текущего ведущего)",
+	"group.help_newgame":               "/newgame [раунды|длительность] - начать новую игру, например /newgame 20 или /newgame 45m (только ведущий)",
+	"group.help_note":                  "Ответ видит только ведущий. Всем он показывается, когда ведущий нажмёт «Раскрыть всем».",
+	"group.help_passhost":              "/passhost - передать роль ведущего (ответом на сообщение игрока)",
+	"group.help_play":                  "/play - задать вопрос (только ведущий)",
+	"group.help_title":                 "Игра в группе:",
+	"group.help_unhost":                "/unhost - перестать быть ведущим (администратор чата может снять текущего ведущего)",
+	"group.host_failed":                "Не удалось назначить ведущего",
+	"group.host_only":                  "Это может сделать только ведущий",
+	"group.host_set":                   "Ведущий: %s\nОтветы видит только ведущий. Начать: /play",
+	"group.host_taken":                 "Ведущий уже назначен: %s\nОн может передать роль командой /passhost в ответ на сообщение игрока, а администратор чата — забрать её командой /host",
+	"group.host_taken_over":            "Администратор чата %s стал ведущим вместо %s\nОтветы видит только ведущий. Начать: /play",
+	"group.need_host":                  "Сначала назначьте ведущего: /host",
+	"group.new_host":                   "Новый ведущий: ",
+	"group.newgame_host_only":          "Начать игру может только ведущий. Стать ведущим: /host",
+	"group.next":                       "➡️ Следующий вопрос",
+	"group.passhost_failed":            "Не удалось передать роль ведущего",
+	"group.passhost_host_only":         "Передать роль может только ведущий",
+	"group.passhost_usage":             "Отправьте /passhost в ответ на сообщение нового ведущего",
+	"group.peek":                       "🔒 Ответ (для ведущего)",
+	"group.question":                   "Вопрос:\n%s\n\nВедущий: %s",
+	"group.reset_done":                 "🔄 Вопросы для этого чата снова новые",
+	"group.reset_note":                 "\nВедущий может начать заново: все вопросы снова станут новыми для этого чата.",
+	"group.reveal":                     "📣 Раскрыть всем",
+	"group.revealed":                   "Вопрос:\n%s\n\nОтвет: %s",
+	"group.unhost_failed":              "Не удалось снять роль ведущего",
+	"group.unhost_host_only":           "Снять роль может только ведущий или администратор чата",
+	"group.unhosted":                   "Ведущего больше нет. Стать ведущим: /host",
+	"help.admin":                       "/admin - админ-панель",
+	"help.endgame":                     "/endgame - завершить игру и показать итоги",
+	"help.games":                       "/games - последние игры",
+	"help.get":                         "/get <id> - информация о пользователе",
+	"help.grant":                       "/grant <id> <роль> - выдать роль",
+	"help.help":                        "/help - список команд",
+	"help.jointeam":                    "/jointeam <uuid> - вступить в команду",
+	"help.menu":                        "/menu - главное меню",
+	"help.newgame":                     "/newgame [раунды|длительность] - новая игра с лимитом, например /newgame 20 или /newgame 45m",
+	"help.play":                        "/play - начать игру",
+	"help.profile":                     "/profile - ваш профиль",
+	"help.revoke":                      "/revoke <id> - снять роль",
+	"help.roles":                       "/roles - роли пользователей",
+	"help.stop":                        "/stop - экстренно остановить текущую форму/пулл",
+	"help.suggest":                     "/suggest - предложить свой вопрос",
+	"help.team":                        "/team - меню команды",
+	"help.title":                       "Доступные команды:",
+	"history.back_to_question":         "⬅ К вопросу",
+	"history.button":                   "📜 История",
+	"history.current":                  " (текущая)",
+	"history.field_answer":             "Ответ: %s",
+	"history.field_category":           "Категория: %s → %s",
+	"history.field_difficulty":         "Сложность: %s → %s",
+	"history.field_language":           "Язык: %s → %s",
+	"history.field_question":           "Вопрос: %s",
+	"history.no_changes":               "Без изменений",
+	"history.original":                 "\nИсходная версия\nВопрос: %s\nОтвет: %s",
+	"history.restore_button":           "↩️ v%d",
+	"history.restore_failed":           "Не удалось восстановить версию",
+	"history.restored":                 "Версия v%d восстановлена",
+	"history.revision":                 "v%d · %s · %s",
+	"history.title":                    "📜 История вопроса — версий: %d\n[-удалено-] {+добавлено+}",
+	"import.all_button":                "✅ Импортировать все корректные (%s)",
+	"import.choose":                    "\n\nИмпортировать все корректные вопросы сразу или просмотреть их по одному?",
+	"import.done":                      "Импорт завершён. Добавлено вопросов: %s",
+	"import.download_failed":           "Не удалось скачать файл, попробуйте ещё раз",
+	"import.duplicate_item":            "• «%s» ≈ «%s» (%d%%)",
+	"import.duplicates":                "Похожи на уже существующие вопросы или повторяются в файле: %s",
+	"import.err_csv":                   "строка %d: не удалось разобрать CSV",
+	"import.err_json":                  "строка %d: некорректный JSON",
+	"import.err_json_array":            "ожидается JSON-массив объектов",
+	"import.err_json_row":              "строка %d: поля должны быть строками",
+	"import.err_language":              "строка %d: неизвестный язык %q",
+	"import.errors_file":               "Полный список ошибок",
+	"import.failed":                    "Не удалось импортировать вопросы",
+	"import.more_duplicates":           "…и ещё %s",
+	"import.more_errors":               "…и ещё %s",
+	"import.new_button":                "🆕 Импортировать только новые (%s)",
+	"import.nothing":                   "\n\nНет вопросов для импорта. Исправьте файл и отправьте его снова.",
+	"import.step_button":               "👀 Просмотреть по одному",
+	"import.summary":                   "Файл: %s\nКорректных вопросов: %s\nСтрок с ошибками: %s",
+	"import.too_large":                 "Файл слишком большой: максимум %d МБ",
+	"import.too_many":                  "В файле больше %s вопросов, разделите его на части",
+	"import.unsupported":               "Поддерживаются файлы .csv, .json и .txt",
+	"label.answer":                     "Ответ: ",
+	"label.author":                     "Автор: ",
+	"label.categories":                 "Категории: ",
+	"label.category":                   "Категория: ",
+	"label.difficulty":                 "Сложность: ",
+	"label.language":                   "Язык: ",
+	"label.packs":                      "Паки: ",
+	"label.question":                   "Вопрос: ",
+	"lang.auto":                        "как в Telegram",
+	"lang.auto_button":                 "🔄 Как в Telegram",
+	"lang.button":                      "🌐 Язык",
+	"lang.en":                          "английский",
+	"lang.en_button":                   "🇬🇧 English",
+	"lang.failed":                      "Не удалось сохранить язык",
+	"lang.prompt":                      "Выберите язык бота\nСейчас: %s",
+	"lang.ru":                          "русский",
+	"lang.ru_button":                   "🇷🇺 Русский",
+	"lang.saved":                       "Язык сохранён",
+	"layout.datetime":                  "02.01.2006 15:04",
+	"layout.datetime_short":            "02.01 15:04",
+	"log.new_user":                     "Новый пользователь:\n%s",
+	"manage.admin_button":              "🛠 Все вопросы",
+	"manage.all_authors":               "👥 Все авторы",
+	"manage.audit_button":              "📋 Журнал действий",
+	"manage.authors_empty":             "Пока нет ни одного вопроса.",
+	"manage.authors_title":             "👤 Выберите автора (в скобках — число вопросов):",
+	"manage.by_author":                 "👤 По автору",
+	"manage.card":                      "Вопрос: %s\nОтвет: %s\nАвтор: %s (id=%d)\nСтатус: %s\nКатегория: %s\nСложность: %s\nЯзык: %s\nРейтинг: 👍 %d · 👎 %d\nДобавлен: %s\nИзменён: %s",
+	"manage.delete_button":             "🗑 Удалить",
+	"manage.delete_confirm":            "Удалить этот вопрос? Он исчезнет у всех игроков и попадёт в вашу корзину, а действие будет записано в журнал.",
+	"manage.deleted":                   "Вопрос удалён и перемещён в вашу корзину",
+	"manage.question_audit_button":     "📋 Журнал вопроса",
+	"manage.reassign_button":           "👤 Передать другому автору",
+	"manage.reassign_prompt":           "Отправьте Telegram ID нового автора (число). Пользователь должен хотя бы раз запустить бота.",
+	"manage.reassign_same_author":      "Этот пользователь уже автор вопроса. Отправьте другой ID.",
+	"manage.reassign_unknown_user":     "Пользователь с таким ID ещё не запускал бота. Отправьте другой ID.",
+	"manage.reassigned":                "Вопрос передан автору %s",
+	"manage.title":                     "🛠 Все вопросы — %s. Здесь можно править, удалять и передавать любые вопросы; каждое действие попадает в журнал.",
+	"manage.title_author":              "🛠 Вопросы автора %s (id=%d) — %s.",
+	"menu.admin":                       "Админка",
+	"menu.play":                        "Играть",
+	"menu.profile":                     "Профиль",
+	"menu.suggest":                     "💡 Предложить вопрос",
+	"menu.team":                        "Команда",
+	"menu.title":                       "Главное меню",
+	"moderation.already_processed":     "Вопрос уже обработан",
+	"moderation.approve":               "✅ Одобрить",
+	"moderation.approve_failed":        "Не удалось одобрить вопрос",
+	"moderation.approved":              "Вопрос одобрен",
+	"moderation.approved_author":       "✅ Ваш вопрос одобрен и добавлен в игру\n\nВопрос: ",
+	"moderation.card":                  "Предложенный вопрос\nАвтор: %s\nОтправлен: %s\n\nВопрос: %s\nОтвет: %s\nКатегория: %s\nСложность: %s\nЯзык: %s",
+	"moderation.empty":                 "Модерация\n\nНовых предложенных вопросов нет",
+	"moderation.list":                  "Модерация\nОжидают проверки: %s",
+	"moderation.new":                   "🛡 Новый вопрос на модерации от %s\n\nВопрос: %s",
+	"moderation.reason_empty":          "Причина не может быть пустой",
+	"moderation.reason_too_long":       "Причина не должна быть длиннее 500 символов",
+	"moderation.reject":                "❌ Отклонить",
+	"moderation.reject_failed":         "Не удалось отклонить вопрос",
+	"moderation.reject_prompt":         "Напишите причину отклонения — её получит автор вопроса\nОтмена: /stop",
+	"moderation.rejected":              "Вопрос отклонён, автор получил причину",
+	"moderation.rejected_author":       "❌ Ваш вопрос отклонён\n\nВопрос: %s\nПричина: %s",
+	"pack.admin_button":                "📦 Паки вопросов",
+	"pack.card":                        "📦 %s\nВ паке: %s",
+	"pack.create_failed":               "Не удалось создать пак",
+	"pack.delete_confirm":              "Удалить пак? Удалить можно только пустой пак.",
+	"pack.delete_failed":               "Не удалось удалить пак",
+	"pack.delete_not_empty":            "В паке есть вопросы — сначала удалите их и очистите из корзины",
+	"pack.delete_yes":                  "🗑 Да, удалить",
+	"pack.deleted":                     "Пак удалён",
+	"pack.description_prompt":          "Введите описание пака (до %d символов) — его увидят игроки при выборе паков",
+	"pack.description_too_long":        "Описание длиннее %d символов",
+	"pack.list":                        "Паки вопросов\nВопросы из паков не попадают в общий набор: игроки получают их, только если включат пак в меню «Игра».",
+	"pack.list_empty":                  "Паков пока нет.\nПак — это отдельная подборка вопросов (например, «Новый год» или «Для детей»), которую игроки включают в меню «Игра».",
+	"pack.new_button":                  "➕ Новый пак",
+	"pack.pool_button":                 "📥 Добавить вопросы в пак",
+	"pack.pool_target":                 "Вопросы будут добавлены в пак «%s».",
+	"pack.skip_description":            "⏭ Без описания",
+	"pack.title_prompt":                "Введите название пака (до %d символов)",
+	"pack.title_too_long":              "Название длиннее %d символов",
+	"pack.unavailable":                 "Пак не найден",
+	"play.all":                         "все",
+	"play.all_categories":              "🔄 Все категории",
+	"play.categories_button":           "🗂 Категории",
+	"play.categories_owner_note":       "\n\nМенять категории может только создатель команды",
+	"play.categories_owner_only":       "Менять категории может только создатель команды",
+	"play.categories_prompt":           "Выберите категории для игры.\nЕсли ничего не выбрано, вопросы берутся из всех категорий.",
+	"play.categories_save_failed":      "Не удалось сохранить категории",
+	"play.difficulty_button":           "🎚 Сложность",
+	"play.difficulty_owner_note":       "\n\nМенять сложность может только создатель команды",
+	"play.difficulty_owner_only":       "Менять сложность может только создатель команды",
+	"play.difficulty_prompt":           "Выберите сложность вопросов.\nСмешанный режим чередует лёгкие, средние и сложные вопросы.",
+	"play.difficulty_save_failed":      "Не удалось сохранить сложность",
+	"play.exhausted":                   "Нет новых вопросов — вы сыграли все подходящие вопросы 🎉\n\nМожно начать заново: история просмотров уйдёт в архив, статистика сохранится. Или измените категории и сложность.",
+	"play.fallback_off":                "⬜ Когда вопросы закончатся — на других языках",
+	"play.fallback_on":                 "✅ Когда вопросы закончатся — на других языках",
+	"play.language_auto":               "как в интерфейсе (%s)",
+	"play.language_auto_button":        "🔄 Как в интерфейсе",
+	"play.language_button":             "🌐 Язык вопросов",
+	"play.language_owner_note":         "\n\nМенять язык вопросов может только создатель команды",
+	"play.language_owner_only":         "Менять язык вопросов может только создатель команды",
+	"play.language_prompt":             "Выберите язык вопросов.\nПо умолчанию вопросы задаются на языке интерфейса.",
+	"play.language_save_failed":        "Не удалось сохранить язык вопросов",
+	"play.menu":                        "Игра\nКатегории: %s\nСложность: %s\nЯзык вопросов: %s",
+	"play.packs_button":                "📦 Паки вопросов",
+	"play.packs_empty":                 "Паков пока нет, вопросы берутся из общего набора.",
+	"play.packs_general":               "общий набор",
+	"play.packs_general_button":        "🗂 Только общий набор",
+	"play.packs_owner_note":            "\n\nМенять паки может только создатель команды",
+	"play.packs_owner_only":            "Менять паки может только создатель команды",
+	"play.packs_prompt":                "Выберите паки вопросов.\nЕсли включён хотя бы один пак, вопросы берутся только из включённых паков; иначе — из общего набора.",
+	"play.packs_save_failed":           "Не удалось сохранить паки",
+	"play.reset_button":                "🔄 Начать заново",
+	"play.reset_confirm_team":          "Начать заново? Все вопросы снова станут новыми для всей команды.",
+	"play.reset_confirm_user":          "Начать заново? Все вопросы снова станут новыми для вас.",
+	"play.reset_done":                  "Прогресс сброшен, в архив ушло вопросов: %s",
+	"play.reset_failed":                "Не удалось сбросить прогресс",
+	"play.reset_note":                  "\nИстория просмотров уйдёт в архив, статистика сохранится.",
+	"play.reset_owner_only":            "Начать заново может только создатель команды",
+	"play.reset_yes":                   "✅ Да, начать заново",
+	"play.settings_button":             "⚙️ Настройки игры",
+	"play.start":                       "▶️ Начать",
+	"play.team_settings":               "\nНастройки общие для всей команды",
+	"pool.done":                        "Пулл завершен. Добавлено: %d из %d",
+	"pool.err_category":                "строка %d: неизвестная категория %q",
+	"pool.err_difficulty":              "строка %d: неизвестная сложность %q",
+	"pool.err_empty":                   "строка %d: вопрос и ответ не могут быть пустыми",
+	"pool.err_format":                  "строка %d: ожидается формат [вопрос]-[ответ]",
+	"pool.err_limit":                   "строка %d: лимит 250 символов на вопрос и ответ",
+	"pool.err_none":                    "не найдено ни одного вопроса",
+	"pool.err_too_many":                "лимит пула: 25 вопросов",
+	"pool.parse_error":                 "Ошибка парсинга: ",
+	"pool.preview":                     "Пулл вопросов (%d/%d)\n\nВопрос: %s\nОтвет: %s\nКатегория: %s\nСложность: %s\nЯзык: %s\n\nПодтвердить добавление?",
+	"pool.prompt":                      "Отправьте пулл вопросов (до 25) в формате:\n[2+2]-[4]\n[4+2]-[6]-[наука]-[лёгкий]\n\nКатегория и сложность в третьих и четвёртых скобках необязательны, по умолчанию «Разное» и «Средний».\n\nБольше вопросов можно загрузить файлом (до 5 000 вопросов):\n• .txt — строки в том же формате;\n• .csv — колонки «вопрос», «ответ», «категория», «сложность», «язык» (заголовок необязателен, разделитель — запятая или точка с запятой);\n• .json — массив объектов с полями question, answer, category, difficulty, language.\nДля экстренной остановки: /stop",
+	"pool.stopped":                     "Пулл остановлен. Добавлено: %d из %d",
+	"profile.language":                 "\nЯзык: %s",
+	"profile.load_failed":              "Не удалось загрузить профиль",
+	"profile.text":                     "Профиль\nИмя: %s\nUsername: %s\nОткрыл ответов: %s\nОчки в команде: %s\nВ игре уже дней: %s\nID: %d",
+	"profile.unavailable":              "Профиль недоступен. Нажмите /start",
+	"question.card":                    "Вопрос: %s\nКатегория: %s\nСложность: %s\nЯзык: %s\nРейтинг: 👍 %d · 👎 %d",
+	"question.delete_confirm":          "Удалить вопрос? Он исчезнет у всех игроков и попадёт в корзину, откуда его можно восстановить.",
+	"question.delete_failed":           "Не удалось удалить вопрос",
+	"question.delete_no":               "❌ Нет, отмена",
+	"question.delete_own_only":         "Можно удалять только свои",
+	"question.delete_yes":              "✅ Да, удалить",
+	"question.deleted":                 "Перемещено в корзину",
+	"question.edit_own_only":           "Можно редактировать только свои",
+	"question.not_yours":               "Это не ваш вопрос",
+	"question.show_answer":             "👁 Показать ответ",
+	"question.status_note":             "\nСтатус: %s%s — в игре не показывается, пока его не проверит модератор",
+	"question.translate":               "🌐 Добавить перевод: %s",
+	"question.translation_exists":      "Перевод на этот язык уже есть",
+	"question.translations":            "\nПереводы: %s",
+	"question.unavailable":             "Вопрос больше недоступен",
+	"questions.empty":                  "Мои вопросы\n\nПока нет добавленных вопросов",
+	"questions.legend":                 "\n⏳ — на модерации, 🚫 — скрыт после жалоб",
+	"questions.title":                  "Мои вопросы",
+	"rating.failed":                    "Не удалось сохранить оценку",
+	"rating.own":                       "Нельзя оценивать свой вопрос",
+	"rating.prompt":                    "Как вам вопрос?",
+	"rating.thanks":                    "Спасибо за оценку",
+	"rating.thanks_with":               "Спасибо за оценку ",
+	"reason.duplicate":                 "Дубликат",
+	"reason.offensive":                 "Оскорбительный",
+	"reason.other":                     "Другое",
+	"reason.wrong":                     "Неверный ответ",
+	"report.already":                   "Вы уже пожаловались на этот вопрос",
+	"report.button":                    "⚠️ Пожаловаться",
+	"report.cancelled":                 "Жалоба отменена",
+	"report.failed":                    "Не удалось отправить жалобу",
+	"report.hidden_note":               "\nВопрос скрыт до проверки",
+	"report.prompt":                    "Что не так с вопросом?",
+	"report.sent":                      "Спасибо! Жалоба отправлена администраторам",
+	"report.unknown_reason":            "Неизвестная причина",
+	"review.closed":                    "Жалобы на этот вопрос уже закрыты",
+	"review.count":                     "Жалоб: %d",
+	"review.deactivate":                "🚫 Деактивировать",
+	"review.deactivate_failed":         "Не удалось деактивировать вопрос",
+	"review.deactivated":               "Вопрос деактивирован",
+	"review.dismiss":                   "✅ Отклонить жалобы",
+	"review.dismiss_failed":            "Не удалось закрыть жалобы",
+	"review.dismissed":                 "Жалобы отклонены, вопрос снова в игре",
+	"review.empty":                     "Жалобы\n\nОткрытых жалоб нет",
+	"review.hidden":                    "Вопрос скрыт до проверки",
+	"review.list":                      "Жалобы\nВопросов с открытыми жалобами: %s\n🙈 — скрыт до проверки",
+	"review.updated":                   "✅ Обновлено, жалобы закрыты",
+	"role.admin":                       "🛡 админ",
+	"role.author":                      "✍️ автор",
+	"role.moderator":                   "🔎 модератор",
+	"role.owner":                       "👑 владелец",
+	"roles.admin_button":               "👥 Роли",
+	"roles.bootstrap":                  " · из ADMIN_IDS",
+	"roles.grant_usage":                "Использование: /grant <id> <owner|admin|moderator|author>",
+	"roles.granted":                    "Пользователю %s выдана роль %s",
+	"roles.granted_user":               "Вам выдана роль %s. Откройте /admin, чтобы увидеть доступные разделы.",
+	"roles.help":                       "\nВыдать роль: /grant <id> <owner|admin|moderator|author>\nСнять роль: /revoke <id>\n\nВладелец управляет ролями; админ модерирует, делает рассылки и правит любые вопросы; модератор добавляет вопросы и модерирует; автор добавляет и правит свои вопросы.",
+	"roles.member":                     "• %s (id=%d) — %s",
+	"roles.no_role":                    "У этого пользователя нет роли.",
+	"roles.protected":                  "Нельзя менять свою роль и роли владельцев из ADMIN_IDS.",
+	"roles.revoke_button":              "❌ Снять роль: %s",
+	"roles.revoke_confirm":             "Снять роль %[2]s у пользователя %[1]s?",
+	"roles.revoke_failed":              "Не удалось снять роль",
+	"roles.revoke_usage":               "Использование: /revoke <id>",
+	"roles.revoke_yes":                 "✅ Да, снять",
+	"roles.revoked":                    "Роль пользователя %s снята",
+	"roles.revoked_user":               "Ваша роль в боте снята.",
+	"roles.title":                      "👥 Роли пользователей:",
+	"score.already":                    "Очко за этот вопрос уже отмечено",
+	"score.failed":                     "Не удалось сохранить очко",
+	"score.nobody":                     "Никто не угадал",
+	"score.nobody_button":              "🙅 Никто",
+	"score.prompt":                     "Кто угадал?",
+	"score.winner":                     "Очко получает: ",
+	"search.again":                     "\n\nОтправьте новый запрос, чтобы искать снова.",
+	"search.button":                    "🔍 Поиск",
+	"search.empty":                     "🔍 «%s»\nНичего не найдено.",
+	"search.prompt":                    "Введите слово или фразу (до %d символов) — найду подходящие вопросы и ответы среди ваших. Слова ищутся с учётом словоформ; фразу в кавычках — целиком, слово с минусом исключается.",
+	"search.results":                   "🔍 «%s»\nНайдено: %s, самые подходящие — первыми.",
+	"search.too_long":                  "Слишком длинный запрос: максимум %d символов",
+	"session.already_running":          "Игра уже идёт. Сначала завершите её: /endgame",
+	"session.answers_revealed":         "Ответов открыто: %s",
+	"session.duration":                 "Длительность: ",
+	"session.end_failed":               "Не удалось завершить игру",
+	"session.ended":                    "🏁 Игра завершена\n\n",
+	"session.game_from":                "Игра от ",
+	"session.history_empty":            "Завершённых игр пока нет",
+	"session.history_failed":           "Не удалось загрузить историю игр",
+	"session.history_title":            "Последние игры:",
+	"session.limit_reached":            "🏁 Лимит игры достигнут\n\n",
+	"session.new_hint":                 "\n\nНовая игра: /newgame",
+	"session.newgame_usage":            "Использование: /newgame [раунды|длительность]\nНапример: /newgame 20 или /newgame 45m",
+	"session.no_limit":                 "без ограничений",
+	"session.none":                     "Сейчас нет активной игры",
+	"session.rounds_played":            "Вопросов сыграно: %s",
+	"session.scores":                   "Очки:",
+	"session.start_failed":             "Не удалось начать игру",
+	"session.started":                  "Новая игра началась\nЛимит: %s\nЗавершить: /endgame",
+	"start.register_failed":            "Не удалось зарегистрировать пользователя",
+	"start.welcome":                    "Добро пожаловать в Громкий вопрос",
+	"status.active":                    "активен",
+	"status.deleted":                   "удалён",
+	"status.draft":                     "на модерации",
+	"status.hidden":                    "скрыт после жалоб",
+	"status.rejected":                  "отклонён",
+	"stop.done":                        "Операция остановлена",
+	"stop.nothing":                     "Нет активной операции",
+	"sub.disabled":                     "Уведомления о новых вопросах отключены",
+	"sub.enabled":                      "Сообщим, когда появятся новые вопросы 🔔",
+	"sub.new_questions":                "🔔 Появились новые вопросы — можно продолжать игру!",
+	"sub.notify_button":                "🔔 Сообщить, когда появятся новые",
+	"sub.off_button":                   "🔕 Не сообщать о новых вопросах",
+	"sub.on_button":                    "🔔 Сообщать о новых вопросах",
+	"sub.on_note":                      "\n🔔 Сообщим, когда появятся новые вопросы",
+	"sub.play_button":                  "▶️ Играть",
+	"suggest.failed":                   "Не удалось отправить вопрос",
+	"suggest.intro":                    "Напишите вопрос, который хотите предложить.\nПосле проверки модератором он появится в игре.\nОтмена: /stop",
+	"suggest.sent":                     "📨 Вопрос отправлен на модерацию. Мы сообщим, когда его проверят",
+	"suggest.submit":                   "📨 Отправить на модерацию",
+	"suggest.too_many":                 "У вас уже %d вопросов на модерации. Дождитесь решения по ним",
+	"team.already_in_this":             "Вы уже в этой команде",
+	"team.already_member":              "Вы уже состоите в команде",
+	"team.bad_uuid":                    "Неверный формат UUID",
+	"team.copy_code":                   "📋 Скопировать код",
+	"team.create":                      "Создать команду",
+	"team.create_failed":               "Не удалось создать команду",
+	"team.full":                        "В команде уже 10 участников",
+	"team.in_other":                    "Вы уже состоите в другой команде",
+	"team.invite":                      "🔗 Инвайт-ссылка",
+	"team.invite_link":                 "Ссылка для входа:\n%s\n\nИли код вручную: %s",
+	"team.invite_text":                 "Тебя пригласили в команду в Громкий вопрос!",
+	"team.join_failed":                 "Не удалось вступить в команду",
+	"team.join_first":                  "Сначала вступите в команду",
+	"team.join_prompt":                 "Введите код: /jointeam <uuid>",
+	"team.join_usage":                  "Использование: /jointeam <uuid>",
+	"team.join_uuid":                   "Вступить по UUID",
+	"team.joined":                      "Вы вступили в команду",
+	"team.kick":                        "Кикнуть %d",
+	"team.kick_failed":                 "Не удалось кикнуть участника",
+	"team.kick_owner_only":             "Кикать может только создатель",
+	"team.kicked":                      "Участник кикнут",
+	"team.leave":                       "🚪 Выйти из команды",
+	"team.left":                        "Вы вышли из команды",
+	"team.load_failed":                 "Ошибка загрузки команды",
+	"team.member_line":                 " | id=%d (%s) | очки: %d",
+	"team.member_not_found":            "Участник не найден в команде",
+	"team.members":                     "👥 Участники",
+	"team.members_failed":              "Не удалось загрузить участников",
+	"team.members_title":               "Участники команды:",
+	"team.no_others":                   "В команде нет других участников",
+	"team.not_found":                   "Команда не найдена",
+	"team.not_member":                  "Вы не состоите в команде",
+	"team.owner_note":                  "\nВы создатель команды",
+	"team.player_not_found":            "Участник не найден",
+	"team.role_member":                 "участник",
+	"team.role_owner":                  "создатель",
+	"team.share":                       "📨 Переслать приглашение",
+	"team.text":                        "Команда\nUUID: %s\nСыграно вопросов: %s\nОчки команды: %s%s",
+	"team.transfer":                    "🔄 Передать команду",
+	"team.transfer_failed":             "Не удалось передать админа",
+	"team.transfer_owner_only":         "Передавать может только создатель",
+	"team.transfer_owner_only_full":    "Передавать команду может только создатель",
+	"team.transfer_prompt":             "Кому передать команду?",
+	"team.transferred":                 "Админ передан",
+	"trash.admin_button":               "🗑 Корзина",
+	"trash.card":                       "🗑 Удалённый вопрос\nВопрос: %s\nОтвет: %s\nКатегория: %s\nСложность: %s\nЯзык: %s\nУдалён: %s",
+	"trash.empty":                      "🗑 Корзина пуста.",
+	"trash.purge_at":                   "\nБудет удалён навсегда: %s",
+	"trash.purge_button":               "🔥 Удалить навсегда",
+	"trash.purge_confirm":              "Удалить вопрос навсегда? Его нельзя будет восстановить, история правок и статистика тоже будут удалены.",
+	"trash.purge_failed":               "Не удалось удалить вопрос",
+	"trash.purge_yes":                  "🔥 Да, удалить навсегда",
+	"trash.purged":                     "Вопрос удалён навсегда",
+	"trash.restore_button":             "♻️ Восстановить",
+	"trash.restore_failed":             "Не удалось восстановить вопрос",
+	"trash.restored":                   "Вопрос восстановлен",
+	"trash.retention":                  "Вопросы удаляются навсегда через %s после удаления.",
+	"trash.retention_off":              "Автоочистка корзины выключена.",
+	"trash.title":                      "🗑 Корзина — удалённые вами вопросы. Их можно восстановить или удалить навсегда.",
+}
+
+var ruPlurals = map[string][]string{
+	"plural.questions": {"%s вопрос", "%s вопроса", "%s вопросов"},
+	"plural.days":      {"%s день", "%s дня", "%s дней"},
+	"plural.packs":     {"%s пак", "%s пака", "%s паков"},
+}
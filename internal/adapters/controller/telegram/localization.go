@@ -0,0 +1,57 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/adapters/controller/telegram/i18n"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"log"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+func tr(ctx context.Context, key string, args ...any) string {
+	return i18n.FromContext(ctx).T(key, args...)
+}
+
+func trn(ctx context.Context, key string, n int) string {
+	return i18n.FromContext(ctx).N(key, n)
+}
+
+func ctxLanguage(ctx context.Context) schema.Language {
+	return schema.Language(i18n.FromContext(ctx).Lang())
+}
+
+func (c *Controller) localize(next tgbot.HandlerFunc) tgbot.HandlerFunc {
+	return func(ctx context.Context, b *tgbot.Bot, upd *models.Update) {
+		var from *models.User
+		switch {
+		case upd.CallbackQuery != nil:
+			from = &upd.CallbackQuery.From
+		case upd.Message != nil:
+			from = upd.Message.From
+		}
+		if from != nil {
+			ctx = i18n.WithLocalizer(ctx, i18n.For(c.userLang(ctx, from.ID, from.LanguageCode)))
+		}
+		next(ctx, b, upd)
+	}
+}
+
+func (c *Controller) userLang(ctx context.Context, userID int64, telegramCode string) i18n.Lang {
+	user, ok, err := c.users.GetByID(ctx, userID)
+	if err != nil {
+		log.Printf("get user language: %v", err)
+	}
+	if !ok {
+		return i18n.Resolve("", telegramCode)
+	}
+	if telegramCode == "" {
+		telegramCode = user.LanguageCode
+	}
+	return i18n.Resolve(string(user.Language), telegramCode)
+}
+
+func (c *Controller) recipientCtx(ctx context.Context, userID int64) context.Context {
+	return i18n.WithLocalizer(ctx, i18n.For(c.userLang(ctx, userID, "")))
+}
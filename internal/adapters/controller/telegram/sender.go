@@ -0,0 +1,108 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/adapters/controller/telegram/i18n"
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/schema"
+	telegramsvc "LoudQuestionBot/internal/domain/service/telegram"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tgbot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+)
+
+type Sender struct {
+	bot  *tgbot.Bot
+	ctrl *Controller
+}
+
+var _ telegramsvc.Sender = (*Sender)(nil)
+
+func (s *Sender) Send(ctx context.Context, msg schema.OutgoingMessage) error {
+	if len(msg.Parts) > 0 || hasLabels(msg.Buttons) {
+		msg = localizeMessage(s.ctrl.recipientCtx(ctx, msg.ChatID), msg)
+	}
+	params := &tgbot.SendMessageParams{
+		ChatID: msg.ChatID,
+		Text:   msg.Text,
+	}
+	if len(msg.Buttons) > 0 {
+		params.ReplyMarkup = outgoingMarkup(msg.Buttons)
+	}
+	_, err := s.bot.SendMessage(ctx, params)
+	return mapSendErr(err)
+}
+
+func hasLabels(buttons [][]schema.OutgoingButton) bool {
+	for _, row := range buttons {
+		for _, b := range row {
+			if b.Label.Key != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func localizeMessage(ctx context.Context, msg schema.OutgoingMessage) schema.OutgoingMessage {
+	var b strings.Builder
+	b.WriteString(msg.Text)
+	for _, part := range msg.Parts {
+		b.WriteString(localizeText(ctx, part))
+	}
+	msg.Text = b.String()
+	buttons := make([][]schema.OutgoingButton, 0, len(msg.Buttons))
+	for _, row := range msg.Buttons {
+		out := make([]schema.OutgoingButton, 0, len(row))
+		for _, btn := range row {
+			if btn.Label.Key != "" {
+				btn.Text = localizeText(ctx, btn.Label)
+			}
+			out = append(out, btn)
+		}
+		buttons = append(buttons, out)
+	}
+	msg.Buttons = buttons
+	return msg
+}
+
+func localizeText(ctx context.Context, text schema.LocalizedText) string {
+	args := make([]any, len(text.Args))
+	for i, a := range text.Args {
+		if n, ok := a.(int); ok {
+			a = i18n.Count(n)
+		}
+		args[i] = a
+	}
+	return tr(ctx, text.Key, args...)
+}
+
+func outgoingMarkup(buttons [][]schema.OutgoingButton) *models.InlineKeyboardMarkup {
+	rows := make([][]models.InlineKeyboardButton, 0, len(buttons))
+	for _, row := range buttons {
+		out := make([]models.InlineKeyboardButton, 0, len(row))
+		for _, b := range row {
+			out = append(out, models.InlineKeyboardButton{Text: b.Text, CallbackData: b.CallbackData})
+		}
+		rows = append(rows, out)
+	}
+	return &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+}
+
+func mapSendErr(err error) error {
+	if err == nil {
+		return nil
+	}
+	var tooMany *tgbot.TooManyRequestsError
+	if errors.As(err, &tooMany) {
+		return &telegramsvc.RetryAfterError{After: time.Duration(tooMany.RetryAfter) * time.Second}
+	}
+	if errors.Is(err, tgbot.ErrorForbidden) {
+		return fmt.Errorf("%w: %v", errorz.ErrForbidden, err)
+	}
+	return err
+}
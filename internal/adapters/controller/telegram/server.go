@@ -3,9 +3,16 @@ package telegram
 import (
 	"LoudQuestionBot/internal/domain/service/access"
 	adminsvc "LoudQuestionBot/internal/domain/service/admin"
+	broadcastsvc "LoudQuestionBot/internal/domain/service/broadcast"
+	dailysvc "LoudQuestionBot/internal/domain/service/daily"
 	"LoudQuestionBot/internal/domain/service/form"
 	gamesvc "LoudQuestionBot/internal/domain/service/game"
+	groupsvc "LoudQuestionBot/internal/domain/service/group"
+	packsvc "LoudQuestionBot/internal/domain/service/pack"
+	sessionsvc "LoudQuestionBot/internal/domain/service/session"
+	subscriptionsvc "LoudQuestionBot/internal/domain/service/subscription"
 	teamsvc "LoudQuestionBot/internal/domain/service/team"
+	trashsvc "LoudQuestionBot/internal/domain/service/trash"
 	usersvc "LoudQuestionBot/internal/domain/service/user"
 	"context"
 	"log"
@@ -17,26 +24,34 @@ import (
 const pageSize = 10
 
 type Runner struct {
-	bot *tgbot.Bot
+	bot  *tgbot.Bot
+	ctrl *Controller
 }
 
 type Controller struct {
-	bot    *tgbot.Bot
-	access *access.Service
-	game   *gamesvc.Service
-	admin  *adminsvc.Service
-	form   *form.Service
-	team   *teamsvc.Service
-	users  *usersvc.Service
+	bot        *tgbot.Bot
+	access     *access.Service
+	game       *gamesvc.Service
+	admin      *adminsvc.Service
+	broadcasts *broadcastsvc.Service
+	daily      *dailysvc.Service
+	form       *form.Service
+	group      *groupsvc.Service
+	packs      *packsvc.Service
+	session    *sessionsvc.Service
+	subs       *subscriptionsvc.Service
+	team       *teamsvc.Service
+	trash      *trashsvc.Service
+	users      *usersvc.Service
 
 	botUsername string
 	logChatID   int64
 }
 
-func New(token string, logChatID int64, accessSvc *access.Service, gameSvc *gamesvc.Service, adminSvc *adminsvc.Service, formSvc *form.Service, teamSvc *teamsvc.Service, userSvc *usersvc.Service) (*Runner, error) {
-	ctrl := &Controller{access: accessSvc, game: gameSvc, admin: adminSvc, form: formSvc, team: teamSvc, users: userSvc, logChatID: logChatID}
+func New(token string, logChatID int64, accessSvc *access.Service, gameSvc *gamesvc.Service, adminSvc *adminsvc.Service, broadcastSvc *broadcastsvc.Service, dailySvc *dailysvc.Service, formSvc *form.Service, groupSvc *groupsvc.Service, packSvc *packsvc.Service, sessionSvc *sessionsvc.Service, subsSvc *subscriptionsvc.Service, teamSvc *teamsvc.Service, trashSvc *trashsvc.Service, userSvc *usersvc.Service) (*Runner, error) {
+	ctrl := &Controller{access: accessSvc, game: gameSvc, admin: adminSvc, broadcasts: broadcastSvc, daily: dailySvc, form: formSvc, group: groupSvc, packs: packSvc, session: sessionSvc, subs: subsSvc, team: teamSvc, trash: trashSvc, users: userSvc, logChatID: logChatID}
 
-	b, err := tgbot.New(token, tgbot.WithDefaultHandler(ctrl.defaultHandler))
+	b, err := tgbot.New(token, tgbot.WithDefaultHandler(ctrl.defaultHandler), tgbot.WithMiddlewares(ctrl.localize))
 	if err != nil {
 		return nil, err
 	}
@@ -47,18 +62,39 @@ func New(token string, logChatID int64, accessSvc *access.Service, gameSvc *game
 	}
 	ctrl.botUsername = me.Username
 
+	b.RegisterHandlerMatchFunc(ctrl.groupCommand("host"), ctrl.groupHostCommand)
+	b.RegisterHandlerMatchFunc(ctrl.groupCommand("passhost"), ctrl.groupPassHostCommand)
+	b.RegisterHandlerMatchFunc(ctrl.groupCommand("unhost"), ctrl.groupUnhostCommand)
+	b.RegisterHandlerMatchFunc(ctrl.groupCommand("play"), ctrl.groupPlayCommand)
+	b.RegisterHandlerMatchFunc(ctrl.groupCommand("newgame"), ctrl.groupNewGameCommand)
+	b.RegisterHandlerMatchFunc(ctrl.groupCommand("endgame"), ctrl.groupEndGameCommand)
+	b.RegisterHandlerMatchFunc(ctrl.groupCommand("games"), ctrl.gamesCommand)
+	b.RegisterHandlerMatchFunc(ctrl.groupCommand("help"), ctrl.groupHelpCommand)
+	b.RegisterHandlerMatchFunc(isGroupMessage, ctrl.ignoreUpdate)
+
 	b.RegisterHandler(tgbot.HandlerTypeMessageText, "/start", tgbot.MatchTypePrefix, ctrl.start)
 	b.RegisterHandler(tgbot.HandlerTypeMessageText, "/menu", tgbot.MatchTypeExact, ctrl.menu)
 	b.RegisterHandler(tgbot.HandlerTypeMessageText, "/stop", tgbot.MatchTypeExact, ctrl.stopCommand)
 	b.RegisterHandler(tgbot.HandlerTypeMessageText, "/play", tgbot.MatchTypeExact, ctrl.playCommand)
+	b.RegisterHandlerMatchFunc(privateCommand("newgame"), ctrl.newGameCommand)
+	b.RegisterHandler(tgbot.HandlerTypeMessageText, "/endgame", tgbot.MatchTypeExact, ctrl.endGameCommand)
+	b.RegisterHandler(tgbot.HandlerTypeMessageText, "/games", tgbot.MatchTypeExact, ctrl.gamesCommand)
 	b.RegisterHandler(tgbot.HandlerTypeMessageText, "/team", tgbot.MatchTypeExact, ctrl.teamCommand)
 	b.RegisterHandler(tgbot.HandlerTypeMessageText, "/profile", tgbot.MatchTypeExact, ctrl.profileCommand)
+	b.RegisterHandler(tgbot.HandlerTypeMessageText, "/suggest", tgbot.MatchTypeExact, ctrl.suggestCommand)
 	b.RegisterHandler(tgbot.HandlerTypeMessageText, "/admin", tgbot.MatchTypeExact, ctrl.adminCommand)
+	b.RegisterHandler(tgbot.HandlerTypeMessageText, "/roles", tgbot.MatchTypeExact, ctrl.rolesCommand)
+	b.RegisterHandler(tgbot.HandlerTypeMessageText, "/grant", tgbot.MatchTypePrefix, ctrl.grantCommand)
+	b.RegisterHandler(tgbot.HandlerTypeMessageText, "/revoke", tgbot.MatchTypePrefix, ctrl.revokeCommand)
 	b.RegisterHandler(tgbot.HandlerTypeMessageText, "/help", tgbot.MatchTypeExact, ctrl.helpCommand)
 	b.RegisterHandler(tgbot.HandlerTypeMessageText, "/jointeam", tgbot.MatchTypePrefix, ctrl.joinTeamByCommand)
 	b.RegisterHandler(tgbot.HandlerTypeMessageText, "/get", tgbot.MatchTypePrefix, ctrl.getUserByID)
 
-	return &Runner{bot: b}, nil
+	return &Runner{bot: b, ctrl: ctrl}, nil
+}
+
+func (r *Runner) Sender() *Sender {
+	return &Sender{bot: r.bot, ctrl: r.ctrl}
 }
 
 func (r *Runner) Start(ctx context.Context) {
@@ -73,6 +109,8 @@ func (c *Controller) defaultHandler(ctx context.Context, b *tgbot.Bot, upd *mode
 		c.handleCallback(ctx, upd)
 	case upd.Message != nil && upd.Message.Text != "":
 		c.handleText(ctx, upd)
+	case upd.Message != nil && upd.Message.Document != nil:
+		c.handleDocument(ctx, upd)
 	}
 }
 
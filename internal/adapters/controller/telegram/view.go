@@ -1,6 +1,7 @@
 package telegram
 
 import (
+	"LoudQuestionBot/internal/adapters/controller/telegram/i18n"
 	"LoudQuestionBot/internal/domain/schema"
 	"context"
 	"fmt"
@@ -13,27 +14,219 @@ import (
 	"github.com/go-telegram/bot/models"
 )
 
-func (c *Controller) mainMenu(userID int64) *models.InlineKeyboardMarkup {
+func (c *Controller) mainMenu(ctx context.Context, userID int64) *models.InlineKeyboardMarkup {
 	rows := [][]models.InlineKeyboardButton{
-		{{Text: "Играть", CallbackData: "play"}},
-		{{Text: "Команда", CallbackData: "team:menu"}},
-		{{Text: "Профиль", CallbackData: "profile:menu"}},
+		{{Text: tr(ctx, "menu.play"), CallbackData: "play:menu"}},
+		{{Text: tr(ctx, "menu.team"), CallbackData: "team:menu"}},
+		{{Text: tr(ctx, "menu.profile"), CallbackData: "profile:menu"}},
+		{{Text: tr(ctx, "menu.suggest"), CallbackData: "sug:add"}},
 	}
-	if c.access.IsAdmin(userID) {
-		rows = append(rows, []models.InlineKeyboardButton{{Text: "Админка", CallbackData: "adm:menu"}})
+	if c.access.IsStaff(userID) {
+		rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "menu.admin"), CallbackData: "adm:menu"}})
 	}
 	return &models.InlineKeyboardMarkup{InlineKeyboard: rows}
 }
 
+func (c *Controller) playScope(ctx context.Context, userID int64) (schema.PlayScope, bool, error) {
+	t, ok, err := c.team.GetByUserID(ctx, userID)
+	if err != nil {
+		return schema.PlayScope{}, false, err
+	}
+	if !ok {
+		return schema.PlayScope{UserID: userID}, true, nil
+	}
+	return schema.PlayScope{UserID: userID, TeamID: t.ID}, t.OwnerID == userID, nil
+}
+
+func (c *Controller) sendPlayMenuWithMessage(ctx context.Context, chatID, userID int64, messageID int) {
+	scope, _, err := c.playScope(ctx, userID)
+	if err != nil {
+		log.Printf("play scope: %v", err)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.error")})
+		return
+	}
+	settings, err := c.game.Settings(ctx, scope)
+	if err != nil {
+		log.Printf("play settings: %v", err)
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "common.error")})
+		return
+	}
+
+	text := tr(ctx, "play.menu", categoriesSummary(ctx, settings.Categories), difficultyTitle(ctx, settings.Difficulty), playLanguageTitle(ctx, settings))
+	text += "\n" + tr(ctx, "label.packs") + c.packsSummary(ctx, settings)
+	if scope.IsTeam() {
+		text += tr(ctx, "play.team_settings")
+	}
+	subscribed, err := c.subs.IsSubscribed(ctx, userID)
+	if err != nil {
+		log.Printf("subscription: %v", err)
+	}
+	subButton := models.InlineKeyboardButton{Text: tr(ctx, "sub.on_button"), CallbackData: "sub:on:menu"}
+	if subscribed {
+		text += tr(ctx, "sub.on_note")
+		subButton = models.InlineKeyboardButton{Text: tr(ctx, "sub.off_button"), CallbackData: "sub:off:menu"}
+	}
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+		{{Text: tr(ctx, "play.start"), CallbackData: "play"}},
+		{{Text: tr(ctx, "play.categories_button"), CallbackData: "play:cats"}},
+		{{Text: tr(ctx, "play.difficulty_button"), CallbackData: "play:diffs"}},
+		{{Text: tr(ctx, "play.language_button"), CallbackData: "play:langs"}},
+		{{Text: tr(ctx, "play.packs_button"), CallbackData: "play:packs"}},
+		{{Text: tr(ctx, "play.reset_button"), CallbackData: "play:reset"}},
+		{subButton},
+		{{Text: tr(ctx, "common.back"), CallbackData: "menu"}},
+	}}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func (c *Controller) sendCategoryPickerWithMessage(ctx context.Context, chatID int64, settings schema.PlaySettings, canEdit bool, messageID int) {
+	text := tr(ctx, "play.categories_prompt")
+	rows := make([][]models.InlineKeyboardButton, 0, len(schema.QuestionCategories)+2)
+	if canEdit {
+		for _, cat := range schema.QuestionCategories {
+			label := categoryTitle(ctx, cat)
+			if settings.HasCategory(cat) {
+				label = "✅ " + label
+			}
+			rows = append(rows, []models.InlineKeyboardButton{{Text: label, CallbackData: "play:cat:" + string(cat)}})
+		}
+		rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "play.all_categories"), CallbackData: "play:cat:all"}})
+	} else {
+		text = tr(ctx, "label.categories") + categoriesSummary(ctx, settings.Categories) + tr(ctx, "play.categories_owner_note")
+	}
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back"), CallbackData: "play:menu"}})
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func playLanguageTitle(ctx context.Context, settings schema.PlaySettings) string {
+	if settings.Language == schema.LanguageAuto {
+		return tr(ctx, "play.language_auto", languageTitle(ctx, ctxLanguage(ctx)))
+	}
+	return languageTitle(ctx, settings.Language)
+}
+
+func (c *Controller) sendPlayLanguagePickerWithMessage(ctx context.Context, chatID int64, settings schema.PlaySettings, canEdit bool, messageID int) {
+	text := tr(ctx, "play.language_prompt")
+	rows := make([][]models.InlineKeyboardButton, 0, len(schema.Languages)+3)
+	if canEdit {
+		auto := tr(ctx, "play.language_auto_button")
+		if settings.Language == schema.LanguageAuto {
+			auto = "✅ " + auto
+		}
+		rows = append(rows, []models.InlineKeyboardButton{{Text: auto, CallbackData: "play:lang:auto"}})
+		for _, lang := range schema.Languages {
+			label := languageTitle(ctx, lang)
+			if settings.Language == lang {
+				label = "✅ " + label
+			}
+			rows = append(rows, []models.InlineKeyboardButton{{Text: label, CallbackData: "play:lang:" + string(lang)}})
+		}
+		fallback := tr(ctx, "play.fallback_off")
+		if settings.LanguageFallback {
+			fallback = tr(ctx, "play.fallback_on")
+		}
+		rows = append(rows, []models.InlineKeyboardButton{{Text: fallback, CallbackData: "play:fb"}})
+	} else {
+		text = tr(ctx, "label.language") + playLanguageTitle(ctx, settings) + tr(ctx, "play.language_owner_note")
+	}
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back"), CallbackData: "play:menu"}})
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func (c *Controller) sendDifficultyPickerWithMessage(ctx context.Context, chatID int64, settings schema.PlaySettings, canEdit bool, messageID int) {
+	text := tr(ctx, "play.difficulty_prompt")
+	rows := make([][]models.InlineKeyboardButton, 0, len(schema.QuestionDifficulties)+2)
+	if canEdit {
+		choices := append([]schema.QuestionDifficulty{schema.DifficultyMixed}, schema.QuestionDifficulties...)
+		for _, d := range choices {
+			label := difficultyTitle(ctx, d)
+			if settings.Difficulty == d {
+				label = "✅ " + label
+			}
+			rows = append(rows, []models.InlineKeyboardButton{{Text: label, CallbackData: "play:diff:" + string(d)}})
+		}
+	} else {
+		text = tr(ctx, "label.difficulty") + difficultyTitle(ctx, settings.Difficulty) + tr(ctx, "play.difficulty_owner_note")
+	}
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back"), CallbackData: "play:menu"}})
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
+	if messageID > 0 {
+		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
+			ChatID:      chatID,
+			MessageID:   messageID,
+			Text:        text,
+			ReplyMarkup: markup,
+		})
+		return
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+}
+
+func categoriesSummary(ctx context.Context, categories []schema.QuestionCategory) string {
+	if len(categories) == 0 {
+		return tr(ctx, "play.all")
+	}
+	titles := make([]string, 0, len(categories))
+	for _, cat := range categories {
+		titles = append(titles, categoryTitle(ctx, cat))
+	}
+	return strings.Join(titles, ", ")
+}
+
 func (c *Controller) sendProfileMenuWithMessage(ctx context.Context, chatID, userID int64, messageID int) {
 	user, ok, err := c.users.GetByID(ctx, userID)
 	if err != nil {
 		log.Printf("profile get user: %v", err)
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Не удалось загрузить профиль"})
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "profile.load_failed")})
 		return
 	}
 	if !ok {
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Профиль недоступен. Нажмите /start"})
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "profile.unavailable")})
 		return
 	}
 
@@ -42,6 +235,11 @@ func (c *Controller) sendProfileMenuWithMessage(ctx context.Context, chatID, use
 		log.Printf("profile answered count: %v", err)
 		answeredCnt = 0
 	}
+	score, err := c.game.UserScore(ctx, userID)
+	if err != nil {
+		log.Printf("profile score: %v", err)
+		score = 0
+	}
 	daysSinceReg := int(time.Since(user.RegisteredAt).Hours() / 24)
 	if daysSinceReg < 0 {
 		daysSinceReg = 0
@@ -50,19 +248,26 @@ func (c *Controller) sendProfileMenuWithMessage(ctx context.Context, chatID, use
 
 	name := strings.TrimSpace(strings.TrimSpace(user.FirstName) + " " + strings.TrimSpace(user.LastName))
 	if name == "" {
-		name = "Без имени"
+		name = tr(ctx, "common.no_name")
 	}
 	uname := "-"
 	if user.Username != "" {
 		uname = "@" + user.Username
 	}
 
-	text := fmt.Sprintf(
-		"Профиль\nИмя: %s\nUsername: %s\nОтветил вопросов: %d\nВ игре уже дней: %d\nID: %d",
-		name, uname, answeredCnt, daysSinceReg, userID,
+	text := tr(ctx, "profile.text",
+		name, uname, i18n.Count(answeredCnt), i18n.Count(score), i18n.Count(daysSinceReg), userID,
 	)
+	dailyButton := models.InlineKeyboardButton{Text: tr(ctx, "daily.on_button"), CallbackData: "day:on"}
+	if user.DailyQuestion {
+		text += tr(ctx, "daily.profile_note") + c.daily.SendTime()
+		dailyButton = models.InlineKeyboardButton{Text: tr(ctx, "daily.off_button"), CallbackData: "day:off"}
+	}
+	text += tr(ctx, "profile.language", languageTitle(ctx, user.Language))
 	markup := &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
-		{{Text: "⬅ Назад", CallbackData: "menu"}},
+		{dailyButton},
+		{{Text: tr(ctx, "lang.button"), CallbackData: "lang:menu"}},
+		{{Text: tr(ctx, "common.back"), CallbackData: "menu"}},
 	}}
 	if messageID > 0 {
 		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
@@ -85,24 +290,64 @@ func (c *Controller) sendAdminMenu(ctx context.Context, chatID int64) {
 }
 
 func (c *Controller) sendAdminMenuWithMessage(ctx context.Context, chatID int64, messageID int) {
-	markup := &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
-		{{Text: "➕ Добавить вопрос", CallbackData: "adm:add"}},
-		{{Text: "📥 Добавить Пулл запросов", CallbackData: "adm:pool"}},
-		{{Text: "📋 Мои вопросы", CallbackData: "adm:list:1"}},
-		{{Text: "⬅ Назад", CallbackData: "menu"}},
-	}}
+	var rows [][]models.InlineKeyboardButton
+	if c.access.Can(chatID, schema.PermissionAuthor) {
+		rows = append(rows,
+			[]models.InlineKeyboardButton{{Text: tr(ctx, "admin.add"), CallbackData: "adm:add"}},
+			[]models.InlineKeyboardButton{{Text: tr(ctx, "admin.pool"), CallbackData: "adm:pool"}},
+			[]models.InlineKeyboardButton{{Text: tr(ctx, "admin.my_questions"), CallbackData: "adm:list:1"}},
+		)
+	}
+	if c.access.Can(chatID, schema.PermissionModerate) {
+		moderation := tr(ctx, "admin.moderation")
+		if pending, err := c.admin.PendingCount(ctx); err != nil {
+			log.Printf("pending count: %v", err)
+		} else if pending > 0 {
+			moderation = tr(ctx, "admin.moderation_count", pending)
+		}
+		reports := tr(ctx, "admin.reports")
+		if reported, err := c.admin.ReportedCount(ctx); err != nil {
+			log.Printf("reported count: %v", err)
+		} else if reported > 0 {
+			reports = tr(ctx, "admin.reports_count", reported)
+		}
+		rows = append(rows,
+			[]models.InlineKeyboardButton{{Text: moderation, CallbackData: "mod:list:1"}},
+			[]models.InlineKeyboardButton{{Text: reports, CallbackData: "rvw:list:1"}},
+		)
+	}
+	if c.access.Can(chatID, schema.PermissionAuthor) {
+		rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "pack.admin_button"), CallbackData: "pk:list"}})
+	}
+	if c.access.Can(chatID, schema.PermissionModerate) {
+		rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "duplicate.admin_button"), CallbackData: "dup:list:1"}})
+	}
+	if c.access.Can(chatID, schema.PermissionAuthor) {
+		rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "trash.admin_button"), CallbackData: "trash:list:1"}})
+	}
+	if c.access.Can(chatID, schema.PermissionBroadcast) {
+		rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "broadcast.button"), CallbackData: "bc:menu"}})
+	}
+	if c.access.Can(chatID, schema.PermissionEditOthers) {
+		rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "manage.admin_button"), CallbackData: "sa:l:0:1"}})
+	}
+	if c.access.Can(chatID, schema.PermissionManageRoles) {
+		rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "roles.admin_button"), CallbackData: "role:list"}})
+	}
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back"), CallbackData: "menu"}})
+	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
 	if messageID > 0 {
 		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
 			ChatID:      chatID,
 			MessageID:   messageID,
-			Text:        "Админ-панель",
+			Text:        tr(ctx, "admin.title"),
 			ReplyMarkup: markup,
 		})
 		return
 	}
 	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
 		ChatID: chatID,
-		Text:   "Админ-панель",
+		Text:   tr(ctx, "admin.title"),
 		ReplyMarkup: markup,
 	})
 }
@@ -112,20 +357,32 @@ func (c *Controller) sendPoolPreview(ctx context.Context, chatID int64, state sc
 		return
 	}
 	item := state.PoolItems[state.PoolIndex]
+	text := tr(ctx, "pool.preview",
+		state.PoolIndex+1,
+		len(state.PoolItems),
+		item.QuestionText,
+		item.AnswerText,
+		categoryTitle(ctx, item.Category),
+		difficultyTitle(ctx, item.Difficulty),
+		languageTitle(ctx, item.Language),
+	)
+	var rows [][]models.InlineKeyboardButton
+	matches, err := c.admin.SimilarQuestions(ctx, item)
+	if err != nil {
+		log.Printf("similar questions: %v", err)
+	} else if len(matches) > 0 {
+		text += "\n\n" + c.duplicateWarning(ctx, matches)
+		rows = duplicateButtons(matches)
+	}
+	rows = append(rows,
+		[]models.InlineKeyboardButton{{Text: tr(ctx, "common.confirm"), CallbackData: "frm:p:c"}},
+		[]models.InlineKeyboardButton{{Text: tr(ctx, "common.edit"), CallbackData: "frm:p:e"}},
+		[]models.InlineKeyboardButton{{Text: tr(ctx, "common.cancel"), CallbackData: "frm:p:x"}},
+	)
 	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
-		ChatID: chatID,
-		Text: fmt.Sprintf(
-			"Пулл вопросов (%d/%d)\n\nВопрос: %s\nОтвет: %s\n\nПодтвердить добавление?",
-			state.PoolIndex+1,
-			len(state.PoolItems),
-			item.QuestionText,
-			item.AnswerText,
-		),
-		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
-			{{Text: "✅ Подтвердить", CallbackData: "frm:p:c"}},
-			{{Text: "✏️ Изменить", CallbackData: "frm:p:e"}},
-			{{Text: "❌ Отмена", CallbackData: "frm:p:x"}},
-		}},
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: rows},
 	})
 }
 
@@ -138,15 +395,15 @@ func (c *Controller) sendMenuWithMessage(ctx context.Context, chatID, userID int
 		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
 			ChatID:      chatID,
 			MessageID:   messageID,
-			Text:        "Главное меню",
-			ReplyMarkup: c.mainMenu(userID),
+			Text:        tr(ctx, "menu.title"),
+			ReplyMarkup: c.mainMenu(ctx, userID),
 		})
 		return
 	}
 	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
 		ChatID:      chatID,
-		Text:        "Главное меню",
-		ReplyMarkup: c.mainMenu(userID),
+		Text:        tr(ctx, "menu.title"),
+		ReplyMarkup: c.mainMenu(ctx, userID),
 	})
 }
 
@@ -158,49 +415,49 @@ func (c *Controller) sendTeamMenuWithMessage(ctx context.Context, chatID, userID
 	team, ok, err := c.team.GetByUserID(ctx, userID)
 	if err != nil {
 		log.Printf("team by user: %v", err)
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Ошибка загрузки команды"})
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.load_failed")})
 		return
 	}
 	if !ok {
 		markup := &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
-			{{Text: "Создать команду", CallbackData: "team:create"}},
-			{{Text: "Вступить по UUID", CallbackData: "team:join:help"}},
-			{{Text: "⬅ Назад", CallbackData: "menu"}},
+			{{Text: tr(ctx, "team.create"), CallbackData: "team:create"}},
+			{{Text: tr(ctx, "team.join_uuid"), CallbackData: "team:join:help"}},
+			{{Text: tr(ctx, "common.back"), CallbackData: "menu"}},
 		}}
 		if messageID > 0 {
 			_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
 				ChatID:      chatID,
 				MessageID:   messageID,
-				Text:        "Вы не состоите в команде",
+				Text:        tr(ctx, "team.not_member"),
 				ReplyMarkup: markup,
 			})
 			return
 		}
 		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
 			ChatID: chatID,
-			Text:   "Вы не состоите в команде",
+			Text:   tr(ctx, "team.not_member"),
 			ReplyMarkup: markup,
 		})
 		return
 	}
 
-	answeredCnt, err := c.game.TeamAnsweredCount(ctx, team.ID)
+	stats, err := c.game.TeamStats(ctx, team, nil)
 	if err != nil {
-		log.Printf("team answered count: %v", err)
-		answeredCnt = 0
+		log.Printf("team stats: %v", err)
+		stats = schema.TeamWithMembers{Team: team}
 	}
 
 	ownerMark := ""
 	if team.OwnerID == userID {
-		ownerMark = "\nВы создатель команды"
+		ownerMark = tr(ctx, "team.owner_note")
 	}
-	text := fmt.Sprintf("Команда\nUUID: %s\nОтвечено вопросов: %d%s", team.ID, answeredCnt, ownerMark)
+	text := tr(ctx, "team.text", team.ID, i18n.Count(stats.SeenCnt), i18n.Count(stats.Score), ownerMark)
 	markup := &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
-		{{Text: "🔗 Инвайт-ссылка", CallbackData: "team:link"}},
-		{{Text: "👥 Участники", CallbackData: "team:members"}},
-		{{Text: "🔄 Передать команду", CallbackData: "team:owner:list"}},
-		{{Text: "🚪 Выйти из команды", CallbackData: "team:leave"}},
-		{{Text: "⬅ Назад", CallbackData: "menu"}},
+		{{Text: tr(ctx, "team.invite"), CallbackData: "team:link"}},
+		{{Text: tr(ctx, "team.members"), CallbackData: "team:members"}},
+		{{Text: tr(ctx, "team.transfer"), CallbackData: "team:owner:list"}},
+		{{Text: tr(ctx, "team.leave"), CallbackData: "team:leave"}},
+		{{Text: tr(ctx, "common.back"), CallbackData: "menu"}},
 	}}
 	if messageID > 0 {
 		_, _ = c.bot.EditMessageText(ctx, &tgbot.EditMessageTextParams{
@@ -225,18 +482,18 @@ func (c *Controller) sendTeamInvite(ctx context.Context, chatID, userID int64) {
 		return
 	}
 	if !ok {
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Сначала вступите в команду"})
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.join_first")})
 		return
 	}
 	link := fmt.Sprintf("https://t.me/%s?start=jointeam-%s", c.botUsername, team.ID)
-	shareText := "Тебя пригласили в команду в Громкий вопрос!"
+	shareText := tr(ctx, "team.invite_text")
 	shareURL := "https://t.me/share/url?url=" + url.QueryEscape(link) + "&text=" + url.QueryEscape(shareText)
 	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
 		ChatID: chatID,
-		Text:   fmt.Sprintf("Ссылка для входа:\n%s\n\nИли код вручную: %s", link, team.ID),
+		Text:   tr(ctx, "team.invite_link", link, team.ID),
 		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
-			{{Text: "📋 Скопировать код", CopyText: models.CopyTextButton{Text: team.ID}}},
-			{{Text: "📨 Переслать приглашение", URL: shareURL}},
+			{{Text: tr(ctx, "team.copy_code"), CopyText: models.CopyTextButton{Text: team.ID}}},
+			{{Text: tr(ctx, "team.share"), URL: shareURL}},
 		}},
 	})
 }
@@ -252,43 +509,48 @@ func (c *Controller) sendTeamMembersWithMessage(ctx context.Context, chatID, use
 		return
 	}
 	if !ok {
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Вы не состоите в команде"})
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.not_member")})
 		return
 	}
 	members, err := c.team.Members(ctx, team.ID)
 	if err != nil {
 		log.Printf("team members: %v", err)
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Не удалось загрузить участников"})
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.members_failed")})
 		return
 	}
+	if stats, err := c.game.TeamStats(ctx, team, members); err != nil {
+		log.Printf("team stats: %v", err)
+	} else {
+		members = stats.Members
+	}
 
 	lines := make([]string, 0, len(members)+1)
-	lines = append(lines, "Участники команды:")
+	lines = append(lines, tr(ctx, "team.members_title"))
 	rows := make([][]models.InlineKeyboardButton, 0, len(members)+2)
 	for _, m := range members {
-		role := "участник"
+		role := tr(ctx, "team.role_member")
 		if m.UserID == team.OwnerID {
-			role = "создатель"
+			role = tr(ctx, "team.role_owner")
 		}
 		fullName := strings.TrimSpace(strings.TrimSpace(m.FirstName) + " " + strings.TrimSpace(m.LastName))
 		if fullName == "" {
-			fullName = "Без имени"
+			fullName = tr(ctx, "common.no_name")
 		}
 		line := fmt.Sprintf("- %s", fullName)
 		if m.Username != "" {
 			line += fmt.Sprintf(" | @%s", m.Username)
 		}
-		line += fmt.Sprintf(" | id=%d (%s)", m.UserID, role)
+		line += tr(ctx, "team.member_line", m.UserID, role, m.Score)
 		lines = append(lines, line)
 		if userID == team.OwnerID && m.UserID != team.OwnerID {
 			rows = append(rows, []models.InlineKeyboardButton{{
-				Text:         fmt.Sprintf("Кикнуть %d", m.UserID),
+				Text:         tr(ctx, "team.kick", m.UserID),
 				CallbackData: fmt.Sprintf("team:kick:%d", m.UserID),
 			}})
 		}
 	}
 
-	rows = append(rows, []models.InlineKeyboardButton{{Text: "⬅ Назад", CallbackData: "team:menu"}})
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back"), CallbackData: "team:menu"}})
 	text := strings.Join(lines, "\n")
 	markup := &models.InlineKeyboardMarkup{InlineKeyboard: rows}
 	if messageID > 0 {
@@ -314,18 +576,18 @@ func (c *Controller) sendTeamOwnerTransferMenu(ctx context.Context, chatID, user
 		return
 	}
 	if !ok {
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Вы не состоите в команде"})
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.not_member")})
 		return
 	}
 	if team.OwnerID != userID {
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Передавать команду может только создатель"})
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.transfer_owner_only_full")})
 		return
 	}
 
 	members, err := c.team.Members(ctx, team.ID)
 	if err != nil {
 		log.Printf("team members: %v", err)
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Не удалось загрузить участников"})
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "team.members_failed")})
 		return
 	}
 
@@ -336,7 +598,7 @@ func (c *Controller) sendTeamOwnerTransferMenu(ctx context.Context, chatID, user
 		}
 		fullName := strings.TrimSpace(strings.TrimSpace(m.FirstName) + " " + strings.TrimSpace(m.LastName))
 		if fullName == "" {
-			fullName = "Без имени"
+			fullName = tr(ctx, "common.no_name")
 		}
 		label := fullName
 		if m.Username != "" {
@@ -348,11 +610,11 @@ func (c *Controller) sendTeamOwnerTransferMenu(ctx context.Context, chatID, user
 			CallbackData: fmt.Sprintf("team:owner:%d", m.UserID),
 		}})
 	}
-	rows = append(rows, []models.InlineKeyboardButton{{Text: "⬅ Назад", CallbackData: "team:menu"}})
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back"), CallbackData: "team:menu"}})
 
-	text := "Кому передать команду?"
+	text := tr(ctx, "team.transfer_prompt")
 	if len(rows) == 1 {
-		text = "В команде нет других участников"
+		text = tr(ctx, "team.no_others")
 	}
 
 	if messageID > 0 {
@@ -395,28 +657,38 @@ func (c *Controller) sendMyQuestions(ctx context.Context, chatID, userID int64,
 	}
 
 	rows := make([][]models.InlineKeyboardButton, 0, len(res.Items)+2)
+	badged := false
 	for i, q := range res.Items {
 		idx := (page-1)*pageSize + i + 1
+		badge := questionStatusBadge(q.Status)
+		badged = badged || badge != ""
 		rows = append(rows, []models.InlineKeyboardButton{{
-			Text:         fmt.Sprintf("%d) %s", idx, shortText(q.QuestionText, 35)),
+			Text:         fmt.Sprintf("%d) %s%s · 👍%d 👎%d", idx, badge, shortText(q.QuestionText, 35), q.Likes, q.Dislikes),
 			CallbackData: fmt.Sprintf("adm:open:%s:%d", q.ID, page),
 		}})
 	}
 
 	nav := []models.InlineKeyboardButton{}
 	if page > 1 {
-		nav = append(nav, models.InlineKeyboardButton{Text: "⬅️ Пред", CallbackData: fmt.Sprintf("adm:list:%d", page-1)})
+		nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.prev"), CallbackData: fmt.Sprintf("adm:list:%d", page-1)})
 	}
-	nav = append(nav, models.InlineKeyboardButton{Text: fmt.Sprintf("Страница %d/%d", page, totalPages), CallbackData: "noop"})
+	nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.page", page, totalPages), CallbackData: "noop"})
 	if page < totalPages {
-		nav = append(nav, models.InlineKeyboardButton{Text: "➡️ След", CallbackData: fmt.Sprintf("adm:list:%d", page+1)})
+		nav = append(nav, models.InlineKeyboardButton{Text: tr(ctx, "common.next"), CallbackData: fmt.Sprintf("adm:list:%d", page+1)})
 	}
 	rows = append(rows, nav)
-	rows = append(rows, []models.InlineKeyboardButton{{Text: "⬅ Назад", CallbackData: "adm:menu"}})
+	if res.Total > 0 {
+		rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "search.button"), CallbackData: "adm:find"}})
+		rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "export.button"), CallbackData: "adm:exp"}})
+	}
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back"), CallbackData: "adm:menu"}})
 
-	text := "Мои вопросы"
+	text := tr(ctx, "questions.title")
+	if badged {
+		text += tr(ctx, "questions.legend")
+	}
 	if res.Total == 0 {
-		text = "Мои вопросы\n\nПока нет добавленных вопросов"
+		text = tr(ctx, "questions.empty")
 	}
 
 	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
@@ -428,49 +700,99 @@ func (c *Controller) sendMyQuestions(ctx context.Context, chatID, userID int64,
 
 func (c *Controller) sendQuestionCard(ctx context.Context, chatID, userID int64, questionID string, page int) {
 	q, err := c.admin.GetQuestion(ctx, questionID)
-	if err != nil || q.Status != schema.QuestionStatusActive {
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Вопрос больше недоступен"})
+	if err != nil || !q.Status.VisibleToAuthor() {
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "question.unavailable")})
 		return
 	}
 	if q.AuthorID != userID {
-		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: "Это не ваш вопрос"})
+		_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{ChatID: chatID, Text: tr(ctx, "question.not_yours")})
 		return
 	}
 	c.sendQuestionCardWithEntity(ctx, chatID, q, page)
 }
 
 func (c *Controller) sendQuestionCardWithEntity(ctx context.Context, chatID int64, q schema.Question, page int) {
+	text := tr(ctx, "question.card", q.QuestionText, categoryTitle(ctx, q.Category), difficultyTitle(ctx, q.Difficulty), languageTitle(ctx, q.Language), q.Likes, q.Dislikes)
+	if q.Status != schema.QuestionStatusActive {
+		text += "\n" + tr(ctx, "label.answer") + q.AnswerText
+		text += tr(ctx, "question.status_note", questionStatusBadge(q.Status), questionStatusTitle(ctx, q.Status))
+	}
+	translations, err := c.admin.Translations(ctx, q.ID)
+	if err != nil {
+		log.Printf("question translations: %v", err)
+	}
+	covered := map[schema.Language]bool{q.Language: true}
+	titles := make([]string, 0, len(translations))
+	for _, t := range translations {
+		covered[t.Language] = true
+		titles = append(titles, languageTitle(ctx, t.Language))
+	}
+	if len(titles) > 0 {
+		text += tr(ctx, "question.translations", strings.Join(titles, ", "))
+	}
+
+	var rows [][]models.InlineKeyboardButton
+	if q.Status == schema.QuestionStatusActive {
+		rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "question.show_answer"), CallbackData: fmt.Sprintf("ans:%s", q.ID)}})
+	}
+	rows = append(rows,
+		[]models.InlineKeyboardButton{{Text: tr(ctx, "common.edit"), CallbackData: fmt.Sprintf("adm:edit:%s:%d", q.ID, page)}},
+		[]models.InlineKeyboardButton{{Text: tr(ctx, "history.button"), CallbackData: fmt.Sprintf("adm:hist:%s:%d:1", q.ID, page)}},
+	)
+	if err == nil && q.Status == schema.QuestionStatusActive {
+		for _, lang := range schema.Languages {
+			if covered[lang] {
+				continue
+			}
+			rows = append(rows, []models.InlineKeyboardButton{{
+				Text:         tr(ctx, "question.translate", languageTitle(ctx, lang)),
+				CallbackData: fmt.Sprintf("adm:tr:%s:%s:%d", q.ID, lang, page),
+			}})
+		}
+	}
+	if q.Status == schema.QuestionStatusActive {
+		rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.delete"), CallbackData: fmt.Sprintf("adm:delask:%s:%d", q.ID, page)}})
+	}
+	rows = append(rows, []models.InlineKeyboardButton{{Text: tr(ctx, "common.back_to_list"), CallbackData: fmt.Sprintf("adm:list:%d", page)}})
 	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
-		ChatID: chatID,
-		Text:   "Вопрос: " + q.QuestionText,
-		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
-			{{Text: "👁 Показать ответ", CallbackData: fmt.Sprintf("ans:%s", q.ID)}},
-			{{Text: "✏️ Изменить", CallbackData: fmt.Sprintf("adm:edit:%s:%d", q.ID, page)}},
-			{{Text: "🗑 Удалить", CallbackData: fmt.Sprintf("adm:delask:%s:%d", q.ID, page)}},
-			{{Text: "⬅ Назад к списку", CallbackData: fmt.Sprintf("adm:list:%d", page)}},
-		}},
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: rows},
 	})
 }
 
 func (c *Controller) sendDraftPreview(ctx context.Context, chatID int64, state schema.FormState) {
 	buttons := [][]models.InlineKeyboardButton{}
-	if state.Mode == schema.FormModeCreate {
+	switch state.Mode {
+	case schema.FormModeCreate:
 		buttons = [][]models.InlineKeyboardButton{
-			{{Text: "✅ Подтвердить", CallbackData: "frm:c"}},
-			{{Text: "✏️ Изменить", CallbackData: "frm:e"}},
-			{{Text: "❌ Отмена", CallbackData: "frm:x"}},
+			{{Text: tr(ctx, "common.confirm"), CallbackData: "frm:c"}},
+			{{Text: tr(ctx, "common.edit"), CallbackData: "frm:e"}},
+			{{Text: tr(ctx, "common.cancel"), CallbackData: "frm:x"}},
 		}
-	} else {
+	case schema.FormModeSuggest:
 		buttons = [][]models.InlineKeyboardButton{
-			{{Text: "✅ Сохранить", CallbackData: "frm:s"}},
-			{{Text: "✏️ Изменить", CallbackData: "frm:e"}},
-			{{Text: "❌ Отмена", CallbackData: "frm:x"}},
+			{{Text: tr(ctx, "suggest.submit"), CallbackData: "frm:c"}},
+			{{Text: tr(ctx, "common.edit"), CallbackData: "frm:e"}},
+			{{Text: tr(ctx, "common.cancel"), CallbackData: "frm:x"}},
+		}
+	default:
+		buttons = [][]models.InlineKeyboardButton{
+			{{Text: tr(ctx, "common.save"), CallbackData: "frm:s"}},
+			{{Text: tr(ctx, "common.edit"), CallbackData: "frm:e"}},
+			{{Text: tr(ctx, "common.cancel"), CallbackData: "frm:x"}},
 		}
 	}
 
 	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
 		ChatID: chatID,
-		Text:   fmt.Sprintf("Предпросмотр\n\nВопрос: %s\nОтвет: %s", state.Draft.QuestionText, state.Draft.AnswerText),
+		Text: tr(ctx, "form.preview",
+			state.Draft.QuestionText,
+			state.Draft.AnswerText,
+			categoryTitle(ctx, state.Draft.Category),
+			difficultyTitle(ctx, state.Draft.Difficulty),
+			languageTitle(ctx, state.Draft.Language),
+		),
 		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: buttons},
 	})
 }
@@ -478,11 +800,65 @@ func (c *Controller) sendDraftPreview(ctx context.Context, chatID int64, state s
 func (c *Controller) sendChooseField(ctx context.Context, chatID int64) {
 	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
 		ChatID: chatID,
-		Text:   "Что изменить?",
+		Text:   tr(ctx, "form.choose_field"),
 		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
-			{{Text: "Вопрос", CallbackData: "frm:f:q"}},
-			{{Text: "Ответ", CallbackData: "frm:f:a"}},
-			{{Text: "Назад", CallbackData: "frm:b"}},
+			{{Text: tr(ctx, "field.question"), CallbackData: "frm:f:q"}},
+			{{Text: tr(ctx, "field.answer"), CallbackData: "frm:f:a"}},
+			{{Text: tr(ctx, "field.category"), CallbackData: "frm:f:c"}},
+			{{Text: tr(ctx, "field.difficulty"), CallbackData: "frm:f:d"}},
+			{{Text: tr(ctx, "field.language"), CallbackData: "frm:f:l"}},
+			{{Text: tr(ctx, "common.back_plain"), CallbackData: "frm:b"}},
 		}},
 	})
 }
+
+func (c *Controller) sendCategoryChooser(ctx context.Context, chatID int64) {
+	rows := make([][]models.InlineKeyboardButton, 0, (len(schema.QuestionCategories)+1)/2)
+	for i := 0; i < len(schema.QuestionCategories); i += 2 {
+		row := []models.InlineKeyboardButton{}
+		for _, cat := range schema.QuestionCategories[i:min(i+2, len(schema.QuestionCategories))] {
+			row = append(row, models.InlineKeyboardButton{Text: categoryTitle(ctx, cat), CallbackData: "frm:cat:" + string(cat)})
+		}
+		rows = append(rows, row)
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        tr(ctx, "form.choose_category"),
+		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: rows},
+	})
+}
+
+func (c *Controller) sendDifficultyChooser(ctx context.Context, chatID int64) {
+	row := make([]models.InlineKeyboardButton, 0, len(schema.QuestionDifficulties))
+	for _, d := range schema.QuestionDifficulties {
+		row = append(row, models.InlineKeyboardButton{Text: difficultyTitle(ctx, d), CallbackData: "frm:dif:" + string(d)})
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        tr(ctx, "form.choose_difficulty"),
+		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{row}},
+	})
+}
+
+func (c *Controller) sendLanguageChooser(ctx context.Context, chatID int64) {
+	row := make([]models.InlineKeyboardButton, 0, len(schema.Languages))
+	for _, lang := range schema.Languages {
+		row = append(row, models.InlineKeyboardButton{Text: languageTitle(ctx, lang), CallbackData: "frm:lng:" + string(lang)})
+	}
+	_, _ = c.bot.SendMessage(ctx, &tgbot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        tr(ctx, "form.choose_language"),
+		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{row}},
+	})
+}
+
+func memberName(ctx context.Context, m schema.TeamMember) string {
+	name := strings.TrimSpace(strings.TrimSpace(m.FirstName) + " " + strings.TrimSpace(m.LastName))
+	if name == "" && m.Username != "" {
+		return "@" + m.Username
+	}
+	if name == "" {
+		return tr(ctx, "common.no_name")
+	}
+	return name
+}
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AuditRepo struct {
+	pool *pgxpool.Pool
+}
+
+var _ repository.AuditRepository = (*AuditRepo)(nil)
+
+func NewAuditRepo(pool *pgxpool.Pool) *AuditRepo {
+	return &AuditRepo{pool: pool}
+}
+
+func (r *AuditRepo) Migrate(ctx context.Context) error {
+	queries := []string{
+		`CREATE TABLE IF NOT EXISTS question_audit_log (
+			id BIGSERIAL PRIMARY KEY,
+			question_id UUID NOT NULL,
+			actor_id BIGINT NOT NULL,
+			action TEXT NOT NULL,
+			from_author_id BIGINT,
+			to_author_id BIGINT,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		);`,
+		`CREATE INDEX IF NOT EXISTS idx_question_audit_log_question ON question_audit_log(question_id, id);`,
+	}
+
+	for _, q := range queries {
+		if _, err := r.pool.Exec(ctx, q); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func recordAudit(ctx context.Context, tx pgx.Tx, entry schema.AuditEntry) error {
+	const query = `
+	INSERT INTO question_audit_log (question_id, actor_id, action, from_author_id, to_author_id)
+	VALUES ($1, $2, $3, NULLIF($4::bigint, 0), NULLIF($5::bigint, 0));
+	`
+	_, err := tx.Exec(ctx, query, entry.QuestionID, entry.ActorID, entry.Action, entry.FromAuthorID, entry.ToAuthorID)
+	return err
+}
+
+func (r *AuditRepo) List(ctx context.Context, questionID string, page, pageSize int) (schema.ListAuditResult, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	offset := (page - 1) * pageSize
+
+	const countQuery = `SELECT COUNT(*) FROM question_audit_log WHERE $1 = '' OR question_id::text = $1;`
+	var total int
+	if err := r.pool.QueryRow(ctx, countQuery, questionID).Scan(&total); err != nil {
+		return schema.ListAuditResult{}, err
+	}
+
+	const query = `
+	SELECT l.id, l.question_id::text, COALESCE(q.question_text, ''), l.actor_id, l.action, COALESCE(l.from_author_id, 0), COALESCE(l.to_author_id, 0), l.created_at
+	FROM question_audit_log l
+	LEFT JOIN questions q ON q.id = l.question_id
+	WHERE $1 = '' OR l.question_id::text = $1
+	ORDER BY l.id DESC
+	LIMIT $2 OFFSET $3;
+	`
+	rows, err := r.pool.Query(ctx, query, questionID, pageSize, offset)
+	if err != nil {
+		return schema.ListAuditResult{}, err
+	}
+	defer rows.Close()
+
+	items := make([]schema.AuditEntry, 0, pageSize)
+	for rows.Next() {
+		var e schema.AuditEntry
+		if err := rows.Scan(&e.ID, &e.QuestionID, &e.QuestionText, &e.ActorID, &e.Action, &e.FromAuthorID, &e.ToAuthorID, &e.CreatedAt); err != nil {
+			return schema.ListAuditResult{}, err
+		}
+		items = append(items, e)
+	}
+	if err := rows.Err(); err != nil {
+		return schema.ListAuditResult{}, err
+	}
+
+	return schema.ListAuditResult{Items: items, Total: total}, nil
+}
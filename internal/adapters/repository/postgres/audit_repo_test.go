@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const testActorID = int64(7)
+
+func breakAuditLog(t *testing.T, pool *pgxpool.Pool) {
+	t.Helper()
+	const query = `ALTER TABLE question_audit_log ADD CONSTRAINT audit_always_fails CHECK (false) NOT VALID;`
+	if _, err := pool.Exec(context.Background(), query); err != nil {
+		t.Fatalf("break audit log: %v", err)
+	}
+}
+
+func loadQuestion(t *testing.T, pool *pgxpool.Pool, id string) (text string, status string, authorID int64) {
+	t.Helper()
+	const query = `SELECT question_text, status, author_id FROM questions WHERE id = $1;`
+	if err := pool.QueryRow(context.Background(), query, id).Scan(&text, &status, &authorID); err != nil {
+		t.Fatalf("load question: %v", err)
+	}
+	return text, status, authorID
+}
+
+func auditedQuestion(t *testing.T) (*pgxpool.Pool, *QuestionRepo, schema.Question) {
+	t.Helper()
+	pool := openTestPool(t)
+	seedQuestions(t, pool, 1)
+	repo := NewQuestionRepo(pool)
+	var q schema.Question
+	const query = `SELECT id::text, question_text, author_id FROM questions LIMIT 1;`
+	if err := pool.QueryRow(context.Background(), query).Scan(&q.ID, &q.QuestionText, &q.AuthorID); err != nil {
+		t.Fatalf("pick question: %v", err)
+	}
+	breakAuditLog(t, pool)
+	return pool, repo, q
+}
+
+func TestUpdateManagedRollsBackOnAuditFailure(t *testing.T) {
+	pool, repo, q := auditedQuestion(t)
+	draft := schema.QuestionDraft{
+		QuestionText: "edited",
+		AnswerText:   "edited",
+		Category:     schema.CategoryOther,
+		Difficulty:   schema.DifficultyMedium,
+		Language:     schema.DefaultLanguage,
+	}
+	if _, err := repo.UpdateManaged(context.Background(), testActorID, q.ID, draft); err == nil {
+		t.Fatal("update succeeded with a broken audit log")
+	}
+	if text, _, _ := loadQuestion(t, pool, q.ID); text != q.QuestionText {
+		t.Errorf("question text = %q, want %q", text, q.QuestionText)
+	}
+}
+
+func TestSoftDeleteManagedRollsBackOnAuditFailure(t *testing.T) {
+	pool, repo, q := auditedQuestion(t)
+	if _, err := repo.SoftDeleteManaged(context.Background(), testActorID, q.ID); err == nil {
+		t.Fatal("delete succeeded with a broken audit log")
+	}
+	if _, status, _ := loadQuestion(t, pool, q.ID); status != string(schema.QuestionStatusActive) {
+		t.Errorf("question status = %q, want active", status)
+	}
+}
+
+func TestReassignAuthorRollsBackOnAuditFailure(t *testing.T) {
+	pool, repo, q := auditedQuestion(t)
+	ctx := context.Background()
+	if _, err := pool.Exec(ctx, `INSERT INTO bot_users (user_id) VALUES ($1);`, testUserID); err != nil {
+		t.Fatalf("seed user: %v", err)
+	}
+	if _, err := repo.ReassignAuthor(ctx, testActorID, q.ID, testUserID); err == nil {
+		t.Fatal("reassign succeeded with a broken audit log")
+	}
+	if _, _, authorID := loadQuestion(t, pool, q.ID); authorID != q.AuthorID {
+		t.Errorf("question author = %d, want %d", authorID, q.AuthorID)
+	}
+}
@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type BroadcastRepo struct {
+	pool *pgxpool.Pool
+}
+
+var _ repository.BroadcastRepository = (*BroadcastRepo)(nil)
+
+func NewBroadcastRepo(pool *pgxpool.Pool) *BroadcastRepo {
+	return &BroadcastRepo{pool: pool}
+}
+
+func (r *BroadcastRepo) Migrate(ctx context.Context) error {
+	queries := []string{
+		`CREATE TABLE IF NOT EXISTS broadcasts (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			author_id BIGINT NOT NULL,
+			text TEXT NOT NULL,
+			audience TEXT NOT NULL,
+			active_days INT NOT NULL DEFAULT 0,
+			status TEXT NOT NULL DEFAULT 'sending',
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			finished_at TIMESTAMPTZ
+		);`,
+		`CREATE INDEX IF NOT EXISTS idx_broadcasts_status_created ON broadcasts(status, created_at);`,
+		`CREATE TABLE IF NOT EXISTS broadcast_recipients (
+			broadcast_id UUID NOT NULL REFERENCES broadcasts(id) ON DELETE CASCADE,
+			user_id BIGINT NOT NULL,
+			status TEXT NOT NULL DEFAULT 'pending',
+			error TEXT NOT NULL DEFAULT '',
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY(broadcast_id, user_id)
+		);`,
+		`CREATE INDEX IF NOT EXISTS idx_broadcast_recipients_pending ON broadcast_recipients(broadcast_id, user_id) WHERE status = 'pending';`,
+	}
+
+	for _, q := range queries {
+		if _, err := r.pool.Exec(ctx, q); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+	}
+	return nil
+}
+
+const broadcastAudienceQuery = `
+	SELECT u.user_id
+	FROM bot_users u
+	WHERE NOT u.is_bot
+	  AND ($1::text <> 'active' OR u.last_interaction_at >= NOW() - make_interval(days => $2::int))
+	  AND ($1::text <> 'owners' OR EXISTS (
+		SELECT 1
+		FROM teams t
+		WHERE t.owner_id = u.user_id
+	))`
+
+const broadcastColumns = `
+	b.id::text, b.author_id, b.text, b.audience, b.active_days, b.status, b.created_at, b.finished_at,
+	s.total, s.pending, s.sent, s.blocked, s.failed`
+
+const broadcastStatsJoin = `
+	CROSS JOIN LATERAL (
+		SELECT COUNT(*) AS total,
+			COUNT(*) FILTER (WHERE br.status = 'pending') AS pending,
+			COUNT(*) FILTER (WHERE br.status = 'sent') AS sent,
+			COUNT(*) FILTER (WHERE br.status = 'blocked') AS blocked,
+			COUNT(*) FILTER (WHERE br.status = 'failed') AS failed
+		FROM broadcast_recipients br
+		WHERE br.broadcast_id = b.id
+	) s`
+
+func scanBroadcast(row pgx.Row) (schema.Broadcast, error) {
+	var out schema.Broadcast
+	err := row.Scan(
+		&out.ID, &out.AuthorID, &out.Text, &out.Audience, &out.ActiveDays, &out.Status, &out.CreatedAt, &out.FinishedAt,
+		&out.Stats.Total, &out.Stats.Pending, &out.Stats.Sent, &out.Stats.Blocked, &out.Stats.Failed,
+	)
+	return out, err
+}
+
+func (r *BroadcastRepo) CountAudience(ctx context.Context, draft schema.BroadcastDraft) (int, error) {
+	var cnt int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM (`+broadcastAudienceQuery+`) a;`, draft.Audience, draft.ActiveDays).Scan(&cnt); err != nil {
+		return 0, err
+	}
+	return cnt, nil
+}
+
+func (r *BroadcastRepo) Create(ctx context.Context, authorID int64, draft schema.BroadcastDraft) (schema.Broadcast, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return schema.Broadcast{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var id string
+	if err := tx.QueryRow(ctx, `
+		INSERT INTO broadcasts (author_id, text, audience, active_days)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id::text;
+	`, authorID, draft.Text, draft.Audience, draft.ActiveDays).Scan(&id); err != nil {
+		return schema.Broadcast{}, err
+	}
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO broadcast_recipients (broadcast_id, user_id)
+		SELECT $3::uuid, a.user_id
+		FROM (`+broadcastAudienceQuery+`) a;
+	`, draft.Audience, draft.ActiveDays, id)
+	if err != nil {
+		return schema.Broadcast{}, err
+	}
+	if tag.RowsAffected() == 0 {
+		return schema.Broadcast{}, errorz.ErrNotFound
+	}
+	out, err := scanBroadcast(tx.QueryRow(ctx, `SELECT `+broadcastColumns+` FROM broadcasts b`+broadcastStatsJoin+` WHERE b.id = $1;`, id))
+	if err != nil {
+		return schema.Broadcast{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return schema.Broadcast{}, err
+	}
+	return out, nil
+}
+
+func (r *BroadcastRepo) NextSending(ctx context.Context) (schema.Broadcast, error) {
+	out, err := scanBroadcast(r.pool.QueryRow(ctx, `
+		SELECT `+broadcastColumns+`
+		FROM broadcasts b`+broadcastStatsJoin+`
+		WHERE b.status = 'sending'
+		ORDER BY b.created_at
+		LIMIT 1;
+	`))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return schema.Broadcast{}, errorz.ErrNotFound
+		}
+		return schema.Broadcast{}, err
+	}
+	return out, nil
+}
+
+func (r *BroadcastRepo) PendingRecipients(ctx context.Context, broadcastID string, limit int) ([]int64, error) {
+	const query = `
+	SELECT user_id
+	FROM broadcast_recipients
+	WHERE broadcast_id = $1 AND status = 'pending'
+	ORDER BY user_id
+	LIMIT $2;
+	`
+	rows, err := r.pool.Query(ctx, query, broadcastID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]int64, 0, limit)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *BroadcastRepo) SetRecipientStatus(ctx context.Context, broadcastID string, userID int64, status schema.RecipientStatus, errText string) error {
+	const query = `
+	UPDATE broadcast_recipients
+	SET status = $3, error = $4, updated_at = NOW()
+	WHERE broadcast_id = $1 AND user_id = $2;
+	`
+	_, err := r.pool.Exec(ctx, query, broadcastID, userID, status, errText)
+	return err
+}
+
+func (r *BroadcastRepo) Finish(ctx context.Context, broadcastID string) (schema.Broadcast, error) {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE broadcasts
+		SET status = 'done', finished_at = NOW()
+		WHERE id = $1 AND status = 'sending';
+	`, broadcastID)
+	if err != nil {
+		return schema.Broadcast{}, err
+	}
+	if tag.RowsAffected() == 0 {
+		return schema.Broadcast{}, errorz.ErrNotFound
+	}
+	return scanBroadcast(r.pool.QueryRow(ctx, `SELECT `+broadcastColumns+` FROM broadcasts b`+broadcastStatsJoin+` WHERE b.id = $1;`, broadcastID))
+}
+
+func (r *BroadcastRepo) ListRecent(ctx context.Context, limit int) ([]schema.Broadcast, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+broadcastColumns+`
+		FROM broadcasts b`+broadcastStatsJoin+`
+		ORDER BY b.created_at DESC
+		LIMIT $1;
+	`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]schema.Broadcast, 0, limit)
+	for rows.Next() {
+		b, err := scanBroadcast(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type DailyQuestionRepo struct {
+	pool *pgxpool.Pool
+}
+
+var _ repository.DailyQuestionRepository = (*DailyQuestionRepo)(nil)
+
+func NewDailyQuestionRepo(pool *pgxpool.Pool) *DailyQuestionRepo {
+	return &DailyQuestionRepo{pool: pool}
+}
+
+func (r *DailyQuestionRepo) Migrate(ctx context.Context) error {
+	queries := []string{
+		`CREATE TABLE IF NOT EXISTS daily_questions (
+			day DATE NOT NULL,
+			language TEXT NOT NULL,
+			question_id UUID NOT NULL REFERENCES questions(id),
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY(day, language)
+		);`,
+		`CREATE INDEX IF NOT EXISTS idx_daily_questions_question ON daily_questions(question_id);`,
+		`ALTER TABLE daily_questions ALTER COLUMN question_id DROP NOT NULL;`,
+		`ALTER TABLE daily_questions ADD COLUMN IF NOT EXISTS question_text TEXT;`,
+		`ALTER TABLE daily_questions ADD COLUMN IF NOT EXISTS answer_text TEXT;`,
+		`ALTER TABLE daily_questions ADD COLUMN IF NOT EXISTS language TEXT;`,
+		keepOnQuestionPurge("daily_questions"),
+		`UPDATE daily_questions d
+		SET question_text = q.question_text, answer_text = q.answer_text
+		FROM questions q
+		WHERE q.id = d.question_id AND d.question_text IS NULL;`,
+		`UPDATE daily_questions d
+		SET language = COALESCE((SELECT q.language FROM questions q WHERE q.id = d.question_id), 'ru')
+		WHERE d.language IS NULL;`,
+		`ALTER TABLE daily_questions ALTER COLUMN language SET NOT NULL;`,
+		`DO $$
+		BEGIN
+			IF NOT EXISTS (
+				SELECT 1
+				FROM pg_constraint c
+				JOIN pg_attribute a ON a.attrelid = c.conrelid AND a.attnum = ANY(c.conkey)
+				WHERE c.conrelid = 'daily_questions'::regclass AND c.contype = 'p' AND a.attname = 'language'
+			) THEN
+				IF to_regclass('daily_question_answers') IS NOT NULL THEN
+					ALTER TABLE daily_question_answers DROP CONSTRAINT IF EXISTS daily_question_answers_day_fkey;
+					ALTER TABLE daily_question_answers ADD COLUMN IF NOT EXISTS language TEXT;
+					UPDATE daily_question_answers a SET language = d.language FROM daily_questions d WHERE d.day = a.day AND a.language IS NULL;
+					ALTER TABLE daily_question_answers ALTER COLUMN language SET NOT NULL;
+					ALTER TABLE daily_question_answers DROP CONSTRAINT daily_question_answers_pkey;
+					ALTER TABLE daily_question_answers ADD PRIMARY KEY (day, language, user_id);
+				END IF;
+				ALTER TABLE daily_questions DROP CONSTRAINT daily_questions_pkey;
+				ALTER TABLE daily_questions ADD PRIMARY KEY (day, language);
+			END IF;
+		END $$;`,
+		`CREATE TABLE IF NOT EXISTS daily_question_answers (
+			day DATE NOT NULL,
+			language TEXT NOT NULL,
+			user_id BIGINT NOT NULL,
+			knew BOOLEAN NOT NULL,
+			answered_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY(day, language, user_id),
+			FOREIGN KEY (day, language) REFERENCES daily_questions(day, language)
+		);`,
+		`DO $$
+		BEGIN
+			IF NOT EXISTS (
+				SELECT 1 FROM pg_constraint
+				WHERE conrelid = 'daily_question_answers'::regclass AND contype = 'f'
+			) THEN
+				ALTER TABLE daily_question_answers ADD FOREIGN KEY (day, language) REFERENCES daily_questions(day, language);
+			END IF;
+		END $$;`,
+	}
+
+	for _, q := range queries {
+		if _, err := r.pool.Exec(ctx, q); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *DailyQuestionRepo) CreateForDay(ctx context.Context, day string, language schema.Language) (schema.DailyQuestion, error) {
+	const query = `
+	INSERT INTO daily_questions (day, language, question_id, question_text, answer_text)
+	SELECT $1::date, $3, q.id, q.question_text, q.answer_text
+	FROM questions q
+	WHERE q.status = 'active'
+	  AND q.pack_id IS NULL
+	  AND q.language = $3
+	  AND NOT EXISTS (
+		SELECT 1
+		FROM daily_questions d
+		WHERE d.question_id = q.id
+	)
+	ORDER BY q.rand_key < $2, q.rand_key
+	LIMIT 1
+	ON CONFLICT (day, language) DO NOTHING
+	RETURNING day::text;
+	`
+	var created string
+	if err := r.pool.QueryRow(ctx, query, day, rand.Float64(), string(language)).Scan(&created); err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return schema.DailyQuestion{}, err
+		}
+		if _, err := r.GetByDay(ctx, day, language); err == nil {
+			return schema.DailyQuestion{}, errorz.ErrAlreadyExists
+		}
+		return schema.DailyQuestion{}, errorz.ErrNotFound
+	}
+	return r.GetByDay(ctx, created, language)
+}
+
+func (r *DailyQuestionRepo) GetByDay(ctx context.Context, day string, language schema.Language) (schema.DailyQuestion, error) {
+	const query = `
+	SELECT d.day::text, d.language, COALESCE(q.id::text, ''), COALESCE(q.question_text, d.question_text, ''), COALESCE(q.answer_text, d.answer_text, ''),
+		COALESCE(q.category, ''), COALESCE(q.difficulty, ''), COALESCE(q.author_id, 0), COALESCE(q.status, 'deleted'), COALESCE(q.likes, 0), COALESCE(q.dislikes, 0),
+		COALESCE(q.language, d.language), COALESCE(q.translation_group::text, ''), COALESCE(q.pack_id::text, ''), COALESCE(q.created_at, d.created_at), COALESCE(q.updated_at, d.created_at)
+	FROM daily_questions d
+	LEFT JOIN questions q ON q.id = d.question_id
+	WHERE d.day = $1::date AND d.language = $2;
+	`
+	var out schema.DailyQuestion
+	dest := append([]any{&out.Day, &out.Language}, questionScanDest(&out.Question)...)
+	if err := r.pool.QueryRow(ctx, query, day, string(language)).Scan(dest...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return schema.DailyQuestion{}, errorz.ErrNotFound
+		}
+		return schema.DailyQuestion{}, err
+	}
+	return out, nil
+}
+
+func (r *DailyQuestionRepo) SaveAnswer(ctx context.Context, day string, language schema.Language, userID int64, knew bool) error {
+	const query = `
+	INSERT INTO daily_question_answers (day, language, user_id, knew)
+	VALUES ($1::date, $2, $3, $4)
+	ON CONFLICT (day, language, user_id) DO UPDATE SET knew = EXCLUDED.knew, answered_at = NOW();
+	`
+	_, err := r.pool.Exec(ctx, query, day, string(language), userID, knew)
+	return err
+}
+
+func (r *DailyQuestionRepo) Summary(ctx context.Context, day string, language schema.Language) (schema.DailySummary, error) {
+	dq, err := r.GetByDay(ctx, day, language)
+	if err != nil {
+		return schema.DailySummary{}, err
+	}
+	const query = `
+	SELECT COUNT(*), COUNT(*) FILTER (WHERE knew)
+	FROM daily_question_answers
+	WHERE day = $1::date AND language = $2;
+	`
+	out := schema.DailySummary{DailyQuestion: dq}
+	if err := r.pool.QueryRow(ctx, query, day, string(language)).Scan(&out.Answered, &out.Knew); err != nil {
+		return schema.DailySummary{}, err
+	}
+	return out, nil
+}
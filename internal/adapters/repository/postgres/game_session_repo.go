@@ -0,0 +1,261 @@
+package postgres
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type GameSessionRepo struct {
+	pool *pgxpool.Pool
+}
+
+var _ repository.GameSessionRepository = (*GameSessionRepo)(nil)
+
+func NewGameSessionRepo(pool *pgxpool.Pool) *GameSessionRepo {
+	return &GameSessionRepo{pool: pool}
+}
+
+func (r *GameSessionRepo) Migrate(ctx context.Context) error {
+	queries := []string{
+		`CREATE EXTENSION IF NOT EXISTS pgcrypto;`,
+		`CREATE TABLE IF NOT EXISTS game_sessions (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			chat_id BIGINT NOT NULL,
+			started_by BIGINT NOT NULL,
+			team_id UUID,
+			round_limit INT NOT NULL DEFAULT 0,
+			duration_sec INT NOT NULL DEFAULT 0,
+			status TEXT NOT NULL DEFAULT 'active',
+			started_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			ended_at TIMESTAMPTZ
+		);`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS uq_game_sessions_active_chat ON game_sessions(chat_id) WHERE status = 'active';`,
+		`CREATE INDEX IF NOT EXISTS idx_game_sessions_chat_started ON game_sessions(chat_id, started_at DESC);`,
+		`CREATE TABLE IF NOT EXISTS game_session_rounds (
+			id BIGSERIAL PRIMARY KEY,
+			session_id UUID NOT NULL REFERENCES game_sessions(id) ON DELETE CASCADE,
+			question_id UUID REFERENCES questions(id) ON DELETE SET NULL,
+			question_text TEXT,
+			drawn_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			revealed_at TIMESTAMPTZ,
+			scorer_id BIGINT
+		);`,
+		`ALTER TABLE game_session_rounds ADD COLUMN IF NOT EXISTS id BIGSERIAL;`,
+		`ALTER TABLE game_session_rounds ADD COLUMN IF NOT EXISTS question_text TEXT;`,
+		rekeyByID("game_session_rounds"),
+		`ALTER TABLE game_session_rounds ALTER COLUMN question_id DROP NOT NULL;`,
+		keepOnQuestionPurge("game_session_rounds"),
+		`DROP INDEX IF EXISTS uq_game_session_rounds_session_question;`,
+		`CREATE INDEX IF NOT EXISTS idx_game_session_rounds_session_question ON game_session_rounds(session_id, question_id, id);`,
+		`UPDATE game_session_rounds r
+		SET question_text = q.question_text
+		FROM questions q
+		WHERE q.id = r.question_id AND r.question_text IS NULL;`,
+	}
+
+	for _, q := range queries {
+		if _, err := r.pool.Exec(ctx, q); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *GameSessionRepo) Create(ctx context.Context, s schema.GameSession) (schema.GameSession, error) {
+	const query = `
+	INSERT INTO game_sessions (chat_id, started_by, team_id, round_limit, duration_sec, status)
+	VALUES ($1, $2, NULLIF($3, '')::uuid, $4, $5, $6)
+	RETURNING id::text, chat_id, started_by, COALESCE(team_id::text, ''), round_limit, duration_sec, status, started_at, ended_at;
+	`
+	out, err := scanGameSession(r.pool.QueryRow(ctx, query,
+		s.ChatID, s.StartedBy, s.TeamID, s.RoundLimit, int(s.Duration/time.Second), s.Status,
+	))
+	if err != nil {
+		return schema.GameSession{}, mapPgErr(err)
+	}
+	return out, nil
+}
+
+func (r *GameSessionRepo) GetActiveByChat(ctx context.Context, chatID int64) (schema.GameSession, bool, error) {
+	const query = `
+	SELECT id::text, chat_id, started_by, COALESCE(team_id::text, ''), round_limit, duration_sec, status, started_at, ended_at
+	FROM game_sessions
+	WHERE chat_id = $1 AND status = 'active';
+	`
+	out, err := scanGameSession(r.pool.QueryRow(ctx, query, chatID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return schema.GameSession{}, false, nil
+		}
+		return schema.GameSession{}, false, err
+	}
+	if out.Rounds, err = r.listRounds(ctx, out.ID); err != nil {
+		return schema.GameSession{}, false, err
+	}
+	return out, true, nil
+}
+
+func (r *GameSessionRepo) GetByID(ctx context.Context, sessionID string) (schema.GameSession, error) {
+	const query = `
+	SELECT id::text, chat_id, started_by, COALESCE(team_id::text, ''), round_limit, duration_sec, status, started_at, ended_at
+	FROM game_sessions
+	WHERE id = $1;
+	`
+	out, err := scanGameSession(r.pool.QueryRow(ctx, query, sessionID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return schema.GameSession{}, errorz.ErrNotFound
+		}
+		return schema.GameSession{}, err
+	}
+	if out.Rounds, err = r.listRounds(ctx, out.ID); err != nil {
+		return schema.GameSession{}, err
+	}
+	return out, nil
+}
+
+func (r *GameSessionRepo) ListFinishedByChat(ctx context.Context, chatID int64, limit int) ([]schema.GameSession, error) {
+	if limit <= 0 {
+		limit = 5
+	}
+	rows, err := r.pool.Query(ctx, `
+		SELECT id::text, chat_id, started_by, COALESCE(team_id::text, ''), round_limit, duration_sec, status, started_at, ended_at
+		FROM game_sessions
+		WHERE chat_id = $1 AND status = 'finished'
+		ORDER BY started_at DESC
+		LIMIT $2;
+	`, chatID, limit)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]schema.GameSession, 0, limit)
+	for rows.Next() {
+		s, err := scanGameSession(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range out {
+		if out[i].Rounds, err = r.listRounds(ctx, out[i].ID); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+const latestRoundQuery = `
+	SELECT id FROM game_session_rounds
+	WHERE session_id = $1 AND question_id = $2
+	ORDER BY id DESC
+	LIMIT 1
+`
+
+func (r *GameSessionRepo) AddRound(ctx context.Context, sessionID string, questionID string) error {
+	const query = `
+	INSERT INTO game_session_rounds (session_id, question_id, question_text)
+	SELECT $1::uuid, q.id, q.question_text
+	FROM questions q
+	WHERE q.id = $2;
+	`
+	_, err := r.pool.Exec(ctx, query, sessionID, questionID)
+	return err
+}
+
+func (r *GameSessionRepo) MarkRoundRevealed(ctx context.Context, sessionID string, questionID string) error {
+	const query = `
+	UPDATE game_session_rounds
+	SET revealed_at = COALESCE(revealed_at, NOW())
+	WHERE id = (` + latestRoundQuery + `);
+	`
+	_, err := r.pool.Exec(ctx, query, sessionID, questionID)
+	return err
+}
+
+func (r *GameSessionRepo) SetRoundScorer(ctx context.Context, sessionID string, questionID string, userID int64) error {
+	const query = `
+	UPDATE game_session_rounds
+	SET scorer_id = NULLIF($3::bigint, 0),
+		revealed_at = COALESCE(revealed_at, NOW())
+	WHERE id = (` + latestRoundQuery + `);
+	`
+	_, err := r.pool.Exec(ctx, query, sessionID, questionID, userID)
+	return err
+}
+
+func (r *GameSessionRepo) Finish(ctx context.Context, sessionID string) error {
+	const query = `
+	UPDATE game_sessions
+	SET status = 'finished', ended_at = NOW()
+	WHERE id = $1 AND status = 'active';
+	`
+	_, err := r.pool.Exec(ctx, query, sessionID)
+	return err
+}
+
+func (r *GameSessionRepo) listRounds(ctx context.Context, sessionID string) ([]schema.GameRound, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT COALESCE(r.question_id::text, ''), COALESCE(q.question_text, r.question_text, ''), r.drawn_at, r.revealed_at IS NOT NULL, COALESCE(r.scorer_id, 0)
+		FROM game_session_rounds r
+		LEFT JOIN questions q ON q.id = r.question_id
+		WHERE r.session_id = $1
+		ORDER BY r.drawn_at ASC, r.id ASC;
+	`, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]schema.GameRound, 0, 16)
+	for rows.Next() {
+		var gr schema.GameRound
+		if err := rows.Scan(&gr.QuestionID, &gr.QuestionText, &gr.DrawnAt, &gr.Revealed, &gr.ScorerID); err != nil {
+			return nil, err
+		}
+		out = append(out, gr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func scanGameSession(row pgx.Row) (schema.GameSession, error) {
+	var (
+		out         schema.GameSession
+		durationSec int
+		endedAt     *time.Time
+	)
+	if err := row.Scan(
+		&out.ID,
+		&out.ChatID,
+		&out.StartedBy,
+		&out.TeamID,
+		&out.RoundLimit,
+		&durationSec,
+		&out.Status,
+		&out.StartedAt,
+		&endedAt,
+	); err != nil {
+		return schema.GameSession{}, err
+	}
+	out.Duration = time.Duration(durationSec) * time.Second
+	if endedAt != nil {
+		out.EndedAt = *endedAt
+	}
+	return out, nil
+}
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PackRepo struct {
+	pool *pgxpool.Pool
+}
+
+var _ repository.PackRepository = (*PackRepo)(nil)
+
+func NewPackRepo(pool *pgxpool.Pool) *PackRepo {
+	return &PackRepo{pool: pool}
+}
+
+func (r *PackRepo) Migrate(ctx context.Context) error {
+	queries := []string{
+		`CREATE TABLE IF NOT EXISTS question_packs (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			title TEXT NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			created_by BIGINT NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		);`,
+		`ALTER TABLE questions ADD COLUMN IF NOT EXISTS pack_id UUID REFERENCES question_packs(id);`,
+		`CREATE INDEX IF NOT EXISTS idx_questions_pack ON questions(pack_id) WHERE pack_id IS NOT NULL;`,
+	}
+
+	for _, q := range queries {
+		if _, err := r.pool.Exec(ctx, q); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *PackRepo) Create(ctx context.Context, authorID int64, draft schema.PackDraft) (schema.Pack, error) {
+	const query = `
+	INSERT INTO question_packs (title, description, created_by)
+	VALUES ($1, $2, $3)
+	RETURNING id::text, title, description, created_by, 0, created_at;
+	`
+	var out schema.Pack
+	if err := r.pool.QueryRow(ctx, query, draft.Title, draft.Description, authorID).Scan(packScanDest(&out)...); err != nil {
+		return schema.Pack{}, err
+	}
+	return out, nil
+}
+
+func (r *PackRepo) GetByID(ctx context.Context, id string) (schema.Pack, error) {
+	const query = `
+	SELECT p.id::text, p.title, p.description, p.created_by,
+		(SELECT COUNT(*) FROM questions q WHERE q.pack_id = p.id AND q.status = 'active'),
+		p.created_at
+	FROM question_packs p
+	WHERE p.id = $1;
+	`
+	var out schema.Pack
+	if err := r.pool.QueryRow(ctx, query, id).Scan(packScanDest(&out)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return schema.Pack{}, errorz.ErrNotFound
+		}
+		return schema.Pack{}, err
+	}
+	return out, nil
+}
+
+func (r *PackRepo) List(ctx context.Context) ([]schema.Pack, error) {
+	const query = `
+	SELECT p.id::text, p.title, p.description, p.created_by, COUNT(q.id), p.created_at
+	FROM question_packs p
+	LEFT JOIN questions q ON q.pack_id = p.id AND q.status = 'active'
+	GROUP BY p.id
+	ORDER BY p.created_at;
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []schema.Pack
+	for rows.Next() {
+		var p schema.Pack
+		if err := rows.Scan(packScanDest(&p)...); err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PackRepo) Delete(ctx context.Context, id string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var used bool
+	const usedQuery = `
+	SELECT EXISTS (
+		SELECT 1 FROM questions
+		WHERE pack_id = $1 AND (status <> 'deleted' OR deleted_by IS NOT NULL)
+	);
+	`
+	if err := tx.QueryRow(ctx, usedQuery, id).Scan(&used); err != nil {
+		return err
+	}
+	if used {
+		return errorz.ErrConflict
+	}
+	if _, err := tx.Exec(ctx, `UPDATE questions SET pack_id = NULL WHERE pack_id = $1 AND status = 'deleted';`, id); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, `UPDATE play_settings SET packs = array_remove(packs, $1::text) WHERE $1::text = ANY(packs);`, id); err != nil {
+		return err
+	}
+	tag, err := tx.Exec(ctx, `DELETE FROM question_packs WHERE id = $1;`, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errorz.ErrNotFound
+	}
+	return tx.Commit(ctx)
+}
+
+func packScanDest(p *schema.Pack) []any {
+	return []any{&p.ID, &p.Title, &p.Description, &p.CreatedBy, &p.Questions, &p.CreatedAt}
+}
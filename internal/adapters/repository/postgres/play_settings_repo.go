@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PlaySettingsRepo struct {
+	pool *pgxpool.Pool
+}
+
+var _ repository.PlaySettingsRepository = (*PlaySettingsRepo)(nil)
+
+func NewPlaySettingsRepo(pool *pgxpool.Pool) *PlaySettingsRepo {
+	return &PlaySettingsRepo{pool: pool}
+}
+
+func (r *PlaySettingsRepo) Migrate(ctx context.Context) error {
+	queries := []string{
+		`CREATE TABLE IF NOT EXISTS play_settings (
+			scope_key TEXT PRIMARY KEY,
+			categories TEXT[] NOT NULL DEFAULT '{}',
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		);`,
+		`ALTER TABLE play_settings ADD COLUMN IF NOT EXISTS difficulty TEXT NOT NULL DEFAULT 'mixed';`,
+		`ALTER TABLE play_settings ADD COLUMN IF NOT EXISTS language TEXT NOT NULL DEFAULT '';`,
+		`ALTER TABLE play_settings ADD COLUMN IF NOT EXISTS language_fallback BOOLEAN NOT NULL DEFAULT TRUE;`,
+		`ALTER TABLE play_settings ADD COLUMN IF NOT EXISTS packs TEXT[] NOT NULL DEFAULT '{}';`,
+	}
+
+	for _, q := range queries {
+		if _, err := r.pool.Exec(ctx, q); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *PlaySettingsRepo) Get(ctx context.Context, scope schema.PlayScope) (schema.PlaySettings, error) {
+	const query = `
+	SELECT categories, difficulty, language, language_fallback, packs, updated_at
+	FROM play_settings
+	WHERE scope_key = $1;
+	`
+	var (
+		out        schema.PlaySettings
+		categories []string
+	)
+	if err := r.pool.QueryRow(ctx, query, scopeKey(scope)).Scan(&categories, &out.Difficulty, &out.Language, &out.LanguageFallback, &out.Packs, &out.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return schema.PlaySettings{Difficulty: schema.DifficultyMixed, LanguageFallback: true}, nil
+		}
+		return schema.PlaySettings{}, err
+	}
+	for _, c := range categories {
+		out.Categories = append(out.Categories, schema.QuestionCategory(c))
+	}
+	return out, nil
+}
+
+func (r *PlaySettingsRepo) Save(ctx context.Context, scope schema.PlayScope, settings schema.PlaySettings) error {
+	const query = `
+	INSERT INTO play_settings (scope_key, categories, difficulty, language, language_fallback, packs)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	ON CONFLICT (scope_key) DO UPDATE
+	SET categories = EXCLUDED.categories,
+		difficulty = EXCLUDED.difficulty,
+		language = EXCLUDED.language,
+		language_fallback = EXCLUDED.language_fallback,
+		packs = EXCLUDED.packs,
+		updated_at = NOW();
+	`
+	filter := settings.Filter()
+	_, err := r.pool.Exec(ctx, query, scopeKey(scope), filter.CategoryKeys(), filter.DifficultyKey(), string(settings.Language), settings.LanguageFallback, filter.PackKeys())
+	return err
+}
+
+func scopeKey(scope schema.PlayScope) string {
+	if scope.IsChat() {
+		return fmt.Sprintf("chat:%d", scope.ChatID)
+	}
+	if scope.IsTeam() {
+		return "team:" + scope.TeamID
+	}
+	return fmt.Sprintf("user:%d", scope.UserID)
+}
@@ -7,8 +7,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand/v2"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -52,6 +55,35 @@ func (r *QuestionRepo) Migrate(ctx context.Context) error {
 			END IF;
 		END $$;`,
 		`CREATE INDEX IF NOT EXISTS idx_questions_author_status ON questions(author_id, status);`,
+		`ALTER TABLE questions ADD COLUMN IF NOT EXISTS category TEXT NOT NULL DEFAULT 'other';`,
+		`CREATE INDEX IF NOT EXISTS idx_questions_status_category ON questions(status, category);`,
+		`ALTER TABLE questions ADD COLUMN IF NOT EXISTS difficulty TEXT NOT NULL DEFAULT 'medium';`,
+		`ALTER TABLE questions ADD COLUMN IF NOT EXISTS rand_key DOUBLE PRECISION NOT NULL DEFAULT random();`,
+		`CREATE INDEX IF NOT EXISTS idx_questions_active_rand_key ON questions(rand_key) WHERE status = 'active';`,
+		`ALTER TABLE questions ADD COLUMN IF NOT EXISTS moderated_by BIGINT;`,
+		`ALTER TABLE questions ADD COLUMN IF NOT EXISTS moderated_at TIMESTAMPTZ;`,
+		`ALTER TABLE questions ADD COLUMN IF NOT EXISTS reject_reason TEXT NOT NULL DEFAULT '';`,
+		`CREATE INDEX IF NOT EXISTS idx_questions_status_created ON questions(status, created_at);`,
+		`ALTER TABLE questions ADD COLUMN IF NOT EXISTS likes INT NOT NULL DEFAULT 0;`,
+		`ALTER TABLE questions ADD COLUMN IF NOT EXISTS dislikes INT NOT NULL DEFAULT 0;`,
+		`ALTER TABLE questions ADD COLUMN IF NOT EXISTS language TEXT NOT NULL DEFAULT 'ru';`,
+		`ALTER TABLE questions ADD COLUMN IF NOT EXISTS translation_group UUID;`,
+		`UPDATE questions SET translation_group = id WHERE translation_group IS NULL;`,
+		`ALTER TABLE questions ALTER COLUMN translation_group SET NOT NULL;`,
+		`CREATE INDEX IF NOT EXISTS idx_questions_translation_group ON questions(translation_group);`,
+		`CREATE INDEX IF NOT EXISTS idx_questions_active_language_rand_key ON questions(language, rand_key) WHERE status = 'active';`,
+		`CREATE EXTENSION IF NOT EXISTS pg_trgm;`,
+		`CREATE OR REPLACE FUNCTION question_norm(t TEXT) RETURNS TEXT
+		LANGUAGE SQL IMMUTABLE PARALLEL SAFE
+		AS $$ SELECT btrim(regexp_replace(replace(lower(t), 'ё', 'е'), '[^[:alnum:]]+', ' ', 'g')) $$;`,
+		`CREATE INDEX IF NOT EXISTS idx_questions_norm_trgm ON questions USING gin (question_norm(question_text) gin_trgm_ops);`,
+		`ALTER TABLE questions ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+			setweight(to_tsvector('russian', question_text), 'A') ||
+			setweight(to_tsvector('english', question_text), 'A') ||
+			setweight(to_tsvector('russian', answer_text), 'B') ||
+			setweight(to_tsvector('english', answer_text), 'B')
+		) STORED;`,
+		`CREATE INDEX IF NOT EXISTS idx_questions_search_vector ON questions USING gin (search_vector);`,
 		`CREATE TABLE IF NOT EXISTS user_seen_questions (
 			user_id BIGINT NOT NULL,
 			question_id UUID NOT NULL REFERENCES questions(id),
@@ -64,12 +96,83 @@ func (r *QuestionRepo) Migrate(ctx context.Context) error {
 			seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
 			PRIMARY KEY(team_id, question_id)
 		);`,
+		`CREATE TABLE IF NOT EXISTS user_seen_questions_archive (
+			user_id BIGINT NOT NULL,
+			question_id UUID NOT NULL REFERENCES questions(id),
+			seen_at TIMESTAMPTZ NOT NULL,
+			archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		);`,
+		`CREATE INDEX IF NOT EXISTS idx_user_seen_questions_archive_user ON user_seen_questions_archive(user_id);`,
+		`CREATE TABLE IF NOT EXISTS team_seen_questions_archive (
+			team_id UUID NOT NULL,
+			question_id UUID NOT NULL REFERENCES questions(id),
+			seen_at TIMESTAMPTZ NOT NULL,
+			archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		);`,
+		`CREATE INDEX IF NOT EXISTS idx_team_seen_questions_archive_team ON team_seen_questions_archive(team_id);`,
+		`ALTER TABLE user_seen_questions ADD COLUMN IF NOT EXISTS translation_group UUID;`,
+		`UPDATE user_seen_questions s
+		SET translation_group = q.translation_group
+		FROM questions q
+		WHERE q.id = s.question_id AND s.translation_group IS NULL;`,
+		`ALTER TABLE user_seen_questions ALTER COLUMN translation_group SET NOT NULL;`,
+		`CREATE INDEX IF NOT EXISTS idx_user_seen_questions_group ON user_seen_questions(user_id, translation_group);`,
+		`ALTER TABLE team_seen_questions ADD COLUMN IF NOT EXISTS translation_group UUID;`,
+		`UPDATE team_seen_questions s
+		SET translation_group = q.translation_group
+		FROM questions q
+		WHERE q.id = s.question_id AND s.translation_group IS NULL;`,
+		`ALTER TABLE team_seen_questions ALTER COLUMN translation_group SET NOT NULL;`,
+		`CREATE INDEX IF NOT EXISTS idx_team_seen_questions_group ON team_seen_questions(team_id, translation_group);`,
+		`CREATE TABLE IF NOT EXISTS chat_seen_questions (
+			chat_id BIGINT NOT NULL,
+			question_id UUID NOT NULL REFERENCES questions(id),
+			translation_group UUID NOT NULL,
+			seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY(chat_id, question_id)
+		);`,
+		`CREATE INDEX IF NOT EXISTS idx_chat_seen_questions_group ON chat_seen_questions(chat_id, translation_group);`,
+		`CREATE INDEX IF NOT EXISTS idx_chat_seen_questions_question ON chat_seen_questions(question_id);`,
+		`ALTER TABLE questions ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;`,
+		`ALTER TABLE questions ADD COLUMN IF NOT EXISTS deleted_by BIGINT;`,
+		`DO $$
+		BEGIN
+			IF to_regclass('question_reports') IS NOT NULL THEN
+				EXECUTE 'UPDATE questions q
+					SET deleted_at = q.updated_at,
+						deleted_by = CASE WHEN EXISTS (
+							SELECT 1 FROM question_reports r WHERE r.question_id = q.id AND r.resolution = ''deactivated''
+						) THEN NULL ELSE q.author_id END
+					WHERE q.status = ''deleted'' AND q.deleted_at IS NULL';
+			ELSE
+				EXECUTE 'UPDATE questions SET deleted_at = updated_at, deleted_by = author_id WHERE status = ''deleted'' AND deleted_at IS NULL';
+			END IF;
+		END $$;`,
+		`CREATE INDEX IF NOT EXISTS idx_questions_deleted_at ON questions(deleted_at) WHERE status = 'deleted';`,
+		`CREATE INDEX IF NOT EXISTS idx_questions_deleted_by ON questions(deleted_by, deleted_at) WHERE status = 'deleted';`,
+		`CREATE TABLE IF NOT EXISTS question_revisions (
+			id BIGSERIAL PRIMARY KEY,
+			question_id UUID NOT NULL REFERENCES questions(id),
+			question_text TEXT NOT NULL,
+			answer_text TEXT NOT NULL,
+			category TEXT NOT NULL,
+			difficulty TEXT NOT NULL,
+			language TEXT NOT NULL,
+			edited_by BIGINT NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		);`,
+		`CREATE INDEX IF NOT EXISTS idx_question_revisions_question ON question_revisions(question_id, id);`,
 		`CREATE TABLE IF NOT EXISTS user_answered_questions (
 			user_id BIGINT NOT NULL,
 			question_id UUID NOT NULL REFERENCES questions(id),
 			answered_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
 			PRIMARY KEY(user_id, question_id)
 		);`,
+		`CREATE INDEX IF NOT EXISTS idx_user_seen_questions_question ON user_seen_questions(question_id);`,
+		`CREATE INDEX IF NOT EXISTS idx_team_seen_questions_question ON team_seen_questions(question_id);`,
+		`CREATE INDEX IF NOT EXISTS idx_user_seen_questions_archive_question ON user_seen_questions_archive(question_id);`,
+		`CREATE INDEX IF NOT EXISTS idx_team_seen_questions_archive_question ON team_seen_questions_archive(question_id);`,
+		`CREATE INDEX IF NOT EXISTS idx_user_answered_questions_question ON user_answered_questions(question_id);`,
 	}
 
 	for _, q := range queries {
@@ -82,41 +185,74 @@ func (r *QuestionRepo) Migrate(ctx context.Context) error {
 
 func (r *QuestionRepo) Create(ctx context.Context, q schema.Question) (schema.Question, error) {
 	const query = `
-	INSERT INTO questions (question_text, answer_text, author_id, status)
-	VALUES ($1, $2, $3, $4)
-	RETURNING id::text, question_text, answer_text, author_id, status, created_at, updated_at;
+	INSERT INTO questions (question_text, answer_text, category, difficulty, author_id, status, language, translation_group, pack_id)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, COALESCE(NULLIF($8, '')::uuid, gen_random_uuid()), NULLIF($9, '')::uuid)
+	RETURNING id::text, question_text, answer_text, category, difficulty, author_id, status, likes, dislikes, language, translation_group::text, COALESCE(pack_id::text, ''), created_at, updated_at;
 	`
 	var out schema.Question
-	if err := r.pool.QueryRow(ctx, query, q.QuestionText, q.AnswerText, q.AuthorID, q.Status).Scan(
-		&out.ID,
-		&out.QuestionText,
-		&out.AnswerText,
-		&out.AuthorID,
-		&out.Status,
-		&out.CreatedAt,
-		&out.UpdatedAt,
-	); err != nil {
+	if err := r.pool.QueryRow(ctx, query, q.QuestionText, q.AnswerText, q.Category, q.Difficulty, q.AuthorID, q.Status, q.Language, q.TranslationGroup, q.PackID).Scan(questionScanDest(&out)...); err != nil {
 		return schema.Question{}, err
 	}
 	return out, nil
 }
 
+func (r *QuestionRepo) CreateBatch(ctx context.Context, authorID int64, questions []schema.Question) (int, error) {
+	const query = `
+	WITH inserted AS (
+		INSERT INTO questions (question_text, answer_text, category, difficulty, author_id, status, language, translation_group, pack_id)
+		SELECT t.question_text, t.answer_text, t.category, t.difficulty, $1, 'active', t.language, gen_random_uuid(), NULLIF(t.pack_id, '')::uuid
+		FROM unnest($2::text[], $3::text[], $4::text[], $5::text[], $6::text[], $7::text[]) AS t(question_text, answer_text, category, difficulty, language, pack_id)
+		RETURNING id, translation_group
+	)
+	INSERT INTO user_seen_questions (user_id, question_id, translation_group)
+	SELECT $1, id, translation_group FROM inserted
+	ON CONFLICT (user_id, question_id) DO NOTHING;
+	`
+	const chunk = 500
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	created := 0
+	for start := 0; start < len(questions); start += chunk {
+		part := questions[start:min(start+chunk, len(questions))]
+		texts := make([]string, len(part))
+		answers := make([]string, len(part))
+		categories := make([]string, len(part))
+		difficulties := make([]string, len(part))
+		languages := make([]string, len(part))
+		packs := make([]string, len(part))
+		for i, q := range part {
+			texts[i] = q.QuestionText
+			answers[i] = q.AnswerText
+			categories[i] = string(q.Category)
+			difficulties[i] = string(q.Difficulty)
+			languages[i] = string(q.Language)
+			packs[i] = q.PackID
+		}
+		tag, err := tx.Exec(ctx, query, authorID, texts, answers, categories, difficulties, languages, packs)
+		if err != nil {
+			return 0, err
+		}
+		created += int(tag.RowsAffected())
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return created, nil
+}
+
 func (r *QuestionRepo) GetByID(ctx context.Context, id string) (schema.Question, error) {
 	const query = `
-	SELECT id::text, question_text, answer_text, author_id, status, created_at, updated_at
+	SELECT id::text, question_text, answer_text, category, difficulty, author_id, status, likes, dislikes, language, translation_group::text, COALESCE(pack_id::text, ''), created_at, updated_at
 	FROM questions
 	WHERE id = $1;
 	`
 	var out schema.Question
-	if err := r.pool.QueryRow(ctx, query, id).Scan(
-		&out.ID,
-		&out.QuestionText,
-		&out.AnswerText,
-		&out.AuthorID,
-		&out.Status,
-		&out.CreatedAt,
-		&out.UpdatedAt,
-	); err != nil {
+	if err := r.pool.QueryRow(ctx, query, id).Scan(questionScanDest(&out)...); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return schema.Question{}, errorz.ErrNotFound
 		}
@@ -125,94 +261,300 @@ func (r *QuestionRepo) GetByID(ctx context.Context, id string) (schema.Question,
 	return out, nil
 }
 
-func (r *QuestionRepo) GetActiveUnseenByUser(ctx context.Context, userID int64) (schema.Question, error) {
+func (r *QuestionRepo) ListTranslations(ctx context.Context, questionID string) ([]schema.Question, error) {
 	const query = `
-	SELECT q.id::text, q.question_text, q.answer_text, q.author_id, q.status, q.created_at, q.updated_at
-	FROM questions q
-	WHERE q.status = 'active'
-	  AND q.author_id <> $1
-	  AND NOT EXISTS (
-		SELECT 1
-		FROM user_seen_questions usq
-		WHERE usq.user_id = $1 AND usq.question_id = q.id
-	)
-	ORDER BY RANDOM()
-	LIMIT 1;
+	SELECT q.id::text, q.question_text, q.answer_text, q.category, q.difficulty, q.author_id, q.status, q.likes, q.dislikes, q.language, q.translation_group::text, COALESCE(q.pack_id::text, ''), q.created_at, q.updated_at
+	FROM questions src
+	JOIN questions q ON q.translation_group = src.translation_group AND q.id <> src.id
+	WHERE src.id = $1 AND q.status = 'active'
+	ORDER BY q.language, q.created_at;
 	`
+	rows, err := r.pool.Query(ctx, query, questionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-	var out schema.Question
-	if err := r.pool.QueryRow(ctx, query, userID).Scan(
-		&out.ID,
-		&out.QuestionText,
-		&out.AnswerText,
-		&out.AuthorID,
-		&out.Status,
-		&out.CreatedAt,
-		&out.UpdatedAt,
-	); err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return schema.Question{}, errorz.ErrNotFound
+	var out []schema.Question
+	for rows.Next() {
+		var q schema.Question
+		if err := rows.Scan(questionScanDest(&q)...); err != nil {
+			return nil, err
 		}
+		out = append(out, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *QuestionRepo) DrawUnseenByUser(ctx context.Context, userID int64, filter schema.QuestionFilter, limit int, pick repository.QuestionPicker) (schema.Question, error) {
+	limit = max(limit, 1)
+	return r.draw(ctx, schema.PlayScope{UserID: userID}, pick, `
+		INSERT INTO user_seen_questions (user_id, question_id, translation_group)
+		SELECT $1::bigint, id, translation_group FROM questions WHERE id = $2;
+	`, userID, sampleUnseenByUserQuery, limit, sampleDifficulties(filter), func(difficulty string) []any {
+		return []any{userID, filter.CategoryKeys(), difficulty, rand.Float64(), limit, filter.LanguageKey(), filter.PackKeys()}
+	})
+}
+
+func (r *QuestionRepo) DrawUnseenByTeam(ctx context.Context, teamID string, userID int64, filter schema.QuestionFilter, limit int, pick repository.QuestionPicker) (schema.Question, error) {
+	limit = max(limit, 1)
+	return r.draw(ctx, schema.PlayScope{UserID: userID, TeamID: teamID}, pick, `
+		INSERT INTO team_seen_questions (team_id, question_id, translation_group)
+		SELECT $1::uuid, id, translation_group FROM questions WHERE id = $2;
+	`, teamID, sampleUnseenByTeamQuery, limit, sampleDifficulties(filter), func(difficulty string) []any {
+		return []any{userID, filter.CategoryKeys(), difficulty, rand.Float64(), limit, filter.LanguageKey(), filter.PackKeys(), teamID}
+	})
+}
+
+func (r *QuestionRepo) DrawUnseenByChat(ctx context.Context, chatID int64, hostID int64, filter schema.QuestionFilter, limit int, pick repository.QuestionPicker) (schema.Question, error) {
+	limit = max(limit, 1)
+	return r.draw(ctx, schema.PlayScope{ChatID: chatID}, pick, `
+		INSERT INTO chat_seen_questions (chat_id, question_id, translation_group)
+		SELECT $1::bigint, id, translation_group FROM questions WHERE id = $2;
+	`, chatID, sampleUnseenByChatQuery, limit, sampleDifficulties(filter), func(difficulty string) []any {
+		return []any{hostID, filter.CategoryKeys(), difficulty, rand.Float64(), limit, filter.LanguageKey(), filter.PackKeys(), chatID}
+	})
+}
+
+func (r *QuestionRepo) draw(ctx context.Context, scope schema.PlayScope, pick repository.QuestionPicker, markQuery string, markKey any, sampleQuery string, limit int, difficulties []string, sampleArgs func(difficulty string) []any) (schema.Question, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return schema.Question{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1, 0));`, "draw:"+scopeKey(scope)); err != nil {
+		return schema.Question{}, err
+	}
+	candidates, err := sampleCandidates(ctx, tx, sampleQuery, limit, difficulties, sampleArgs)
+	if err != nil {
+		return schema.Question{}, err
+	}
+	if len(candidates) == 0 {
+		return schema.Question{}, errorz.ErrNotFound
+	}
+	q := pick(candidates)
+	if _, err := tx.Exec(ctx, markQuery, markKey, q.ID); err != nil {
+		return schema.Question{}, mapPgErr(err)
+	}
+	if err := tx.Commit(ctx); err != nil {
 		return schema.Question{}, err
 	}
+	return q, nil
+}
+
+var (
+	sampleUnseenByUserQuery = buildSampleQuery(`
+		NOT EXISTS (
+			SELECT 1
+			FROM user_seen_questions usq
+			WHERE usq.user_id = $1 AND usq.translation_group = q.translation_group
+		)`)
+	sampleUnseenByTeamQuery = buildSampleQuery(`
+		NOT EXISTS (
+			SELECT 1
+			FROM team_seen_questions tsq
+			WHERE tsq.team_id = $8 AND tsq.translation_group = q.translation_group
+		)`)
+	sampleUnseenByChatQuery = buildSampleQuery(`
+		NOT EXISTS (
+			SELECT 1
+			FROM chat_seen_questions csq
+			WHERE csq.chat_id = $8 AND csq.translation_group = q.translation_group
+		)`)
+)
+
+func sampleDifficulties(filter schema.QuestionFilter) []string {
+	if filter.Difficulty.Valid() {
+		return []string{string(filter.Difficulty)}
+	}
+	out := make([]string, 0, len(schema.QuestionDifficulties))
+	for _, d := range schema.QuestionDifficulties {
+		out = append(out, string(d))
+	}
+	return out
+}
+
+func buildSampleQuery(unseen string) string {
+	const half = `
+	(
+		SELECT q.id::text, q.question_text, q.answer_text, q.category, q.difficulty, q.author_id, q.status, q.likes, q.dislikes, q.language, q.translation_group::text, COALESCE(q.pack_id::text, ''), q.created_at, q.updated_at
+		FROM questions q
+		WHERE q.status = 'active'
+		  AND q.rand_key %s $4
+		  AND q.author_id <> $1
+		  AND (cardinality($2::text[]) = 0 OR q.category = ANY($2::text[]))
+		  AND ($3 = 'mixed' OR q.difficulty = $3)
+		  AND ($6 = '' OR q.language = $6)
+		  AND (q.pack_id = ANY($7::text[]::uuid[]) OR (cardinality($7::text[]) = 0 AND q.pack_id IS NULL))
+		  AND %s
+		ORDER BY q.rand_key
+		LIMIT $5
+	)`
+	return fmt.Sprintf(half, ">=", unseen) + "\n\tUNION ALL" + fmt.Sprintf(half, "<", unseen) + "\n\tLIMIT $5;"
+}
+
+func sampleCandidates(ctx context.Context, tx pgx.Tx, query string, limit int, difficulties []string, sampleArgs func(difficulty string) []any) ([]schema.Question, error) {
+	out := make([]schema.Question, 0, limit*len(difficulties))
+	for _, difficulty := range difficulties {
+		sample, err := sampleQuestions(ctx, tx, query, limit, sampleArgs(difficulty)...)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, sample...)
+	}
 	return out, nil
 }
 
-func (r *QuestionRepo) GetActiveUnseenByTeam(ctx context.Context, teamID string, userID int64) (schema.Question, error) {
-	const query = `
-	SELECT q.id::text, q.question_text, q.answer_text, q.author_id, q.status, q.created_at, q.updated_at
-	FROM questions q
-	WHERE q.status = 'active'
-	  AND q.author_id <> $2
-	  AND NOT EXISTS (
-		SELECT 1
-		FROM team_seen_questions tsq
-		WHERE tsq.team_id = $1 AND tsq.question_id = q.id
-	)
-	ORDER BY RANDOM()
-	LIMIT 1;
-	`
+func sampleQuestions(ctx context.Context, tx pgx.Tx, query string, limit int, args ...any) ([]schema.Question, error) {
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-	var out schema.Question
-	if err := r.pool.QueryRow(ctx, query, teamID, userID).Scan(
-		&out.ID,
-		&out.QuestionText,
-		&out.AnswerText,
-		&out.AuthorID,
-		&out.Status,
-		&out.CreatedAt,
-		&out.UpdatedAt,
-	); err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return schema.Question{}, errorz.ErrNotFound
+	out := make([]schema.Question, 0, limit)
+	for rows.Next() {
+		var q schema.Question
+		if err := rows.Scan(questionScanDest(&q)...); err != nil {
+			return nil, err
 		}
-		return schema.Question{}, err
+		out = append(out, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 	return out, nil
 }
 
+func (r *QuestionRepo) CountUnseenByUser(ctx context.Context, userID int64, filter schema.QuestionFilter, limit int) (int, error) {
+	return r.countUnseen(ctx, countUnseenByUserQuery, userID, filter.CategoryKeys(), filter.DifficultyKey(), max(limit, 1), filter.LanguageKey(), filter.PackKeys())
+}
+
+func (r *QuestionRepo) CountUnseenByTeam(ctx context.Context, teamID string, userID int64, filter schema.QuestionFilter, limit int) (int, error) {
+	return r.countUnseen(ctx, countUnseenByTeamQuery, userID, filter.CategoryKeys(), filter.DifficultyKey(), max(limit, 1), filter.LanguageKey(), filter.PackKeys(), teamID)
+}
+
+func (r *QuestionRepo) countUnseen(ctx context.Context, query string, args ...any) (int, error) {
+	var cnt int
+	if err := r.pool.QueryRow(ctx, query, args...).Scan(&cnt); err != nil {
+		return 0, err
+	}
+	return cnt, nil
+}
+
+var (
+	countUnseenByUserQuery = buildCountUnseenQuery(`
+		NOT EXISTS (
+			SELECT 1
+			FROM user_seen_questions usq
+			WHERE usq.user_id = $1 AND usq.translation_group = q.translation_group
+		)`)
+	countUnseenByTeamQuery = buildCountUnseenQuery(`
+		NOT EXISTS (
+			SELECT 1
+			FROM team_seen_questions tsq
+			WHERE tsq.team_id = $7 AND tsq.translation_group = q.translation_group
+		)`)
+)
+
+func buildCountUnseenQuery(unseen string) string {
+	return fmt.Sprintf(`
+	SELECT COUNT(*)
+	FROM (
+		SELECT 1
+		FROM questions q
+		WHERE q.status = 'active'
+		  AND q.author_id <> $1
+		  AND (cardinality($2::text[]) = 0 OR q.category = ANY($2::text[]))
+		  AND ($3 = 'mixed' OR q.difficulty = $3)
+		  AND ($5 = '' OR q.language = $5)
+		  AND (q.pack_id = ANY($6::text[]::uuid[]) OR (cardinality($6::text[]) = 0 AND q.pack_id IS NULL))
+		  AND %s
+		LIMIT $4
+	) unseen;
+	`, unseen)
+}
+
 func (r *QuestionRepo) MarkSeenByUser(ctx context.Context, userID int64, questionID string) error {
 	const query = `
-	INSERT INTO user_seen_questions (user_id, question_id)
-	VALUES ($1, $2)
+	INSERT INTO user_seen_questions (user_id, question_id, translation_group)
+	SELECT $1::bigint, id, translation_group FROM questions WHERE id = $2
 	ON CONFLICT (user_id, question_id) DO NOTHING;
 	`
 	_, err := r.pool.Exec(ctx, query, userID, questionID)
 	return err
 }
 
-func (r *QuestionRepo) MarkSeenByTeam(ctx context.Context, teamID string, questionID string) error {
-	const query = `
-	INSERT INTO team_seen_questions (team_id, question_id)
-	VALUES ($1, $2)
-	ON CONFLICT (team_id, question_id) DO NOTHING;
-	`
-	_, err := r.pool.Exec(ctx, query, teamID, questionID)
-	return err
+func (r *QuestionRepo) ResetSeenByUser(ctx context.Context, userID int64) (int, error) {
+	return r.archiveSeen(ctx, schema.PlayScope{UserID: userID}, `
+		WITH moved AS (
+			DELETE FROM user_seen_questions
+			WHERE user_id = $1
+			RETURNING user_id, question_id, seen_at
+		)
+		INSERT INTO user_seen_questions_archive (user_id, question_id, seen_at)
+		SELECT user_id, question_id, seen_at FROM moved;
+	`, userID)
+}
+
+func (r *QuestionRepo) ResetSeenByTeam(ctx context.Context, teamID string) (int, error) {
+	return r.archiveSeen(ctx, schema.PlayScope{TeamID: teamID}, `
+		WITH moved AS (
+			DELETE FROM team_seen_questions
+			WHERE team_id = $1
+			RETURNING team_id, question_id, seen_at
+		), scores AS (
+			UPDATE team_question_scores
+			SET archived_at = NOW()
+			WHERE team_id = $1 AND archived_at IS NULL
+		)
+		INSERT INTO team_seen_questions_archive (team_id, question_id, seen_at)
+		SELECT team_id, question_id, seen_at FROM moved;
+	`, teamID)
+}
+
+func (r *QuestionRepo) ResetSeenByChat(ctx context.Context, chatID int64) (int, error) {
+	return r.archiveSeen(ctx, schema.PlayScope{ChatID: chatID}, `DELETE FROM chat_seen_questions WHERE chat_id = $1;`, chatID)
+}
+
+func (r *QuestionRepo) archiveSeen(ctx context.Context, scope schema.PlayScope, query string, key any) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1, 0));`, "draw:"+scopeKey(scope)); err != nil {
+		return 0, err
+	}
+	tag, err := tx.Exec(ctx, query, key)
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (r *QuestionRepo) IsSeenByTeam(ctx context.Context, teamID string, questionID string) (bool, error) {
+	const query = `SELECT EXISTS (SELECT 1 FROM team_seen_questions WHERE team_id = $1 AND question_id = $2);`
+	var ok bool
+	if err := r.pool.QueryRow(ctx, query, teamID, questionID).Scan(&ok); err != nil {
+		return false, err
+	}
+	return ok, nil
 }
 
 func (r *QuestionRepo) CountSeenByTeam(ctx context.Context, teamID string) (int, error) {
-	const query = `SELECT COUNT(*) FROM team_seen_questions WHERE team_id = $1;`
+	const query = `
+	SELECT (SELECT COUNT(*) FROM team_seen_questions WHERE team_id = $1)
+		+ (SELECT COUNT(*) FROM team_seen_questions_archive WHERE team_id = $1);
+	`
 	var cnt int
 	if err := r.pool.QueryRow(ctx, query, teamID).Scan(&cnt); err != nil {
 		return 0, err
@@ -248,16 +590,16 @@ func (r *QuestionRepo) ListByAuthor(ctx context.Context, authorID int64, page, p
 	}
 	offset := (page - 1) * pageSize
 
-	const countQuery = `SELECT COUNT(*) FROM questions WHERE author_id = $1 AND status = 'active';`
+	const countQuery = `SELECT COUNT(*) FROM questions WHERE author_id = $1 AND status IN ('active', 'hidden', 'draft');`
 	var total int
 	if err := r.pool.QueryRow(ctx, countQuery, authorID).Scan(&total); err != nil {
 		return repository.ListQuestionsResult{}, err
 	}
 
 	const query = `
-	SELECT id::text, question_text, answer_text, author_id, status, created_at, updated_at
+	SELECT id::text, question_text, answer_text, category, difficulty, author_id, status, likes, dislikes, language, translation_group::text, COALESCE(pack_id::text, ''), created_at, updated_at
 	FROM questions
-	WHERE author_id = $1 AND status = 'active'
+	WHERE author_id = $1 AND status IN ('active', 'hidden', 'draft')
 	ORDER BY created_at DESC
 	LIMIT $2 OFFSET $3;
 	`
@@ -270,15 +612,7 @@ func (r *QuestionRepo) ListByAuthor(ctx context.Context, authorID int64, page, p
 	items := make([]schema.Question, 0, pageSize)
 	for rows.Next() {
 		var q schema.Question
-		if err := rows.Scan(
-			&q.ID,
-			&q.QuestionText,
-			&q.AnswerText,
-			&q.AuthorID,
-			&q.Status,
-			&q.CreatedAt,
-			&q.UpdatedAt,
-		); err != nil {
+		if err := rows.Scan(questionScanDest(&q)...); err != nil {
 			return repository.ListQuestionsResult{}, err
 		}
 		items = append(items, q)
@@ -290,46 +624,851 @@ func (r *QuestionRepo) ListByAuthor(ctx context.Context, authorID int64, page, p
 	return repository.ListQuestionsResult{Items: items, Total: total}, nil
 }
 
-func (r *QuestionRepo) UpdateByAuthor(ctx context.Context, authorID int64, questionID string, draft schema.QuestionDraft) (schema.Question, error) {
+func (r *QuestionRepo) SearchByAuthor(ctx context.Context, authorID int64, query string, page, pageSize int) (repository.ListQuestionsResult, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	offset := (page - 1) * pageSize
+
+	const countQuery = `
+	SELECT COUNT(*)
+	FROM questions
+	WHERE author_id = $1 AND status IN ('active', 'hidden', 'draft')
+		AND search_vector @@ (websearch_to_tsquery('russian', $2) || websearch_to_tsquery('english', $2));
+	`
+	var total int
+	if err := r.pool.QueryRow(ctx, countQuery, authorID, query).Scan(&total); err != nil {
+		return repository.ListQuestionsResult{}, err
+	}
+
+	const searchQuery = `
+	SELECT q.id::text, q.question_text, q.answer_text, q.category, q.difficulty, q.author_id, q.status, q.likes, q.dislikes, q.language, q.translation_group::text, COALESCE(q.pack_id::text, ''), q.created_at, q.updated_at
+	FROM questions q,
+		LATERAL (SELECT websearch_to_tsquery('russian', $2) || websearch_to_tsquery('english', $2) AS tsq) s
+	WHERE q.author_id = $1 AND q.status IN ('active', 'hidden', 'draft') AND q.search_vector @@ s.tsq
+	ORDER BY ts_rank_cd(q.search_vector, s.tsq) DESC, q.created_at DESC
+	LIMIT $3 OFFSET $4;
+	`
+	rows, err := r.pool.Query(ctx, searchQuery, authorID, query, pageSize, offset)
+	if err != nil {
+		return repository.ListQuestionsResult{}, err
+	}
+	defer rows.Close()
+
+	items := make([]schema.Question, 0, pageSize)
+	for rows.Next() {
+		var q schema.Question
+		if err := rows.Scan(questionScanDest(&q)...); err != nil {
+			return repository.ListQuestionsResult{}, err
+		}
+		items = append(items, q)
+	}
+	if err := rows.Err(); err != nil {
+		return repository.ListQuestionsResult{}, err
+	}
+
+	return repository.ListQuestionsResult{Items: items, Total: total}, nil
+}
+
+func (r *QuestionRepo) ExportByAuthor(ctx context.Context, authorID int64) ([]schema.QuestionExport, error) {
 	const query = `
-	UPDATE questions
-	SET question_text = $1,
-		answer_text = $2,
-		updated_at = NOW()
-	WHERE id = $3 AND author_id = $4 AND status = 'active'
-	RETURNING id::text, question_text, answer_text, author_id, status, created_at, updated_at;
+	SELECT q.id::text, q.question_text, q.answer_text, q.category, q.difficulty, q.author_id, q.status, q.likes, q.dislikes, q.language, q.translation_group::text, COALESCE(q.pack_id::text, ''), q.created_at, q.updated_at,
+		(SELECT COUNT(*) FROM user_seen_questions s WHERE s.question_id = q.id AND s.user_id <> q.author_id)
+			+ (SELECT COUNT(*) FROM user_seen_questions_archive s WHERE s.question_id = q.id AND s.user_id <> q.author_id)
+			+ (SELECT COUNT(*) FROM team_seen_questions s WHERE s.question_id = q.id)
+			+ (SELECT COUNT(*) FROM team_seen_questions_archive s WHERE s.question_id = q.id)
+			+ (SELECT COUNT(*) FROM chat_seen_questions s WHERE s.question_id = q.id),
+		(SELECT COUNT(*) FROM user_answered_questions a WHERE a.question_id = q.id),
+		(SELECT COUNT(*) FROM question_reports r WHERE r.question_id = q.id)
+	FROM questions q
+	WHERE q.author_id = $1 AND q.status <> 'deleted'
+	ORDER BY q.created_at;
 	`
+	rows, err := r.pool.Query(ctx, query, authorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-	var out schema.Question
-	if err := r.pool.QueryRow(ctx, query, draft.QuestionText, draft.AnswerText, questionID, authorID).Scan(
-		&out.ID,
-		&out.QuestionText,
-		&out.AnswerText,
-		&out.AuthorID,
-		&out.Status,
-		&out.CreatedAt,
-		&out.UpdatedAt,
-	); err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return schema.Question{}, errorz.ErrForbidden
+	var out []schema.QuestionExport
+	for rows.Next() {
+		var e schema.QuestionExport
+		dest := append(questionScanDest(&e.Question), &e.Plays, &e.Guessed, &e.Reports)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
 		}
-		return schema.Question{}, err
+		out = append(out, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 	return out, nil
 }
 
-func (r *QuestionRepo) SoftDeleteByAuthor(ctx context.Context, authorID int64, questionID string) error {
+func (r *QuestionRepo) FindSimilar(ctx context.Context, text string, language schema.Language, threshold float64, limit int) ([]schema.SimilarQuestion, error) {
 	const query = `
-	UPDATE questions
-	SET status = 'deleted', updated_at = NOW()
-	WHERE id = $1 AND author_id = $2 AND status = 'active';
+	SELECT q.id::text, q.question_text, q.answer_text, q.category, q.difficulty, q.author_id, q.status, q.likes, q.dislikes, q.language, q.translation_group::text, COALESCE(q.pack_id::text, ''), q.created_at, q.updated_at,
+		similarity(question_norm(q.question_text), question_norm($1))::float8 AS sim
+	FROM questions q
+	WHERE q.status IN ('active', 'draft', 'hidden')
+		AND q.language = $2
+		AND question_norm(q.question_text) % question_norm($1)
+		AND similarity(question_norm(q.question_text), question_norm($1)) >= $3
+	ORDER BY sim DESC, q.created_at
+	LIMIT $4;
 	`
-	tag, err := r.pool.Exec(ctx, query, questionID, authorID)
+	rows, err := r.pool.Query(ctx, query, text, language, threshold, limit)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if tag.RowsAffected() == 0 {
-		return errorz.ErrForbidden
+	defer rows.Close()
+
+	var out []schema.SimilarQuestion
+	for rows.Next() {
+		var m schema.SimilarQuestion
+		if err := rows.Scan(append(questionScanDest(&m.Question), &m.Similarity)...); err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *QuestionRepo) FindSimilarBatch(ctx context.Context, drafts []schema.QuestionDraft, threshold float64) (map[int]schema.SimilarQuestion, error) {
+	const query = `
+	SELECT t.idx, m.id, m.question_text, m.answer_text, m.category, m.difficulty, m.author_id, m.status, m.likes, m.dislikes, m.language, m.translation_group, m.pack_id, m.created_at, m.updated_at, m.sim
+	FROM unnest($1::text[], $2::text[]) WITH ORDINALITY AS t(question_text, language, idx)
+	CROSS JOIN LATERAL (
+		SELECT q.id::text AS id, q.question_text, q.answer_text, q.category, q.difficulty, q.author_id, q.status, q.likes, q.dislikes, q.language, q.translation_group::text AS translation_group, COALESCE(q.pack_id::text, '') AS pack_id, q.created_at, q.updated_at,
+			similarity(question_norm(q.question_text), question_norm(t.question_text))::float8 AS sim
+		FROM questions q
+		WHERE q.status IN ('active', 'draft', 'hidden')
+			AND q.language = t.language
+			AND question_norm(q.question_text) % question_norm(t.question_text)
+			AND similarity(question_norm(q.question_text), question_norm(t.question_text)) >= $3
+		ORDER BY sim DESC, q.created_at
+		LIMIT 1
+	) m;
+	`
+	out := make(map[int]schema.SimilarQuestion)
+	if len(drafts) == 0 {
+		return out, nil
+	}
+	texts := make([]string, len(drafts))
+	languages := make([]string, len(drafts))
+	for i, d := range drafts {
+		texts[i] = d.QuestionText
+		languages[i] = string(d.Language)
+	}
+	rows, err := r.pool.Query(ctx, query, texts, languages, threshold)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var idx int
+		var m schema.SimilarQuestion
+		dest := append([]any{&idx}, questionScanDest(&m.Question)...)
+		if err := rows.Scan(append(dest, &m.Similarity)...); err != nil {
+			return nil, err
+		}
+		out[idx-1] = m
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *QuestionRepo) ListSimilarPairs(ctx context.Context, threshold float64, limit int) ([]schema.DuplicatePair, error) {
+	const query = `
+	SELECT a.id::text, a.question_text, a.answer_text, a.category, a.difficulty, a.author_id, a.status, a.likes, a.dislikes, a.language, a.translation_group::text, COALESCE(a.pack_id::text, ''), a.created_at, a.updated_at,
+		b.id::text, b.question_text, b.answer_text, b.category, b.difficulty, b.author_id, b.status, b.likes, b.dislikes, b.language, b.translation_group::text, COALESCE(b.pack_id::text, ''), b.created_at, b.updated_at,
+		similarity(question_norm(a.question_text), question_norm(b.question_text))::float8 AS sim
+	FROM questions a
+	JOIN questions b ON b.id > a.id
+		AND b.language = a.language
+		AND question_norm(b.question_text) % question_norm(a.question_text)
+	WHERE a.status IN ('active', 'draft', 'hidden')
+		AND b.status IN ('active', 'draft', 'hidden')
+		AND similarity(question_norm(a.question_text), question_norm(b.question_text)) >= $1
+	ORDER BY sim DESC, a.created_at
+	LIMIT $2;
+	`
+	rows, err := r.pool.Query(ctx, query, threshold, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []schema.DuplicatePair
+	for rows.Next() {
+		var p schema.DuplicatePair
+		dest := append(questionScanDest(&p.Left), questionScanDest(&p.Right)...)
+		if err := rows.Scan(append(dest, &p.Similarity)...); err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *QuestionRepo) UpdateByAuthor(ctx context.Context, authorID int64, questionID string, draft schema.QuestionDraft) (schema.Question, error) {
+	const query = `
+	UPDATE questions
+	SET question_text = $1,
+		answer_text = $2,
+		category = $3,
+		difficulty = $4,
+		language = $7,
+		updated_at = NOW()
+	WHERE id = $5 AND author_id = $6 AND status IN ('active', 'hidden', 'draft')
+	RETURNING id::text, question_text, answer_text, category, difficulty, author_id, status, likes, dislikes, language, translation_group::text, COALESCE(pack_id::text, ''), created_at, updated_at;
+	`
+
+	return r.updateWithRevision(ctx, questionID, authorID, "", errorz.ErrForbidden, query, draft.QuestionText, draft.AnswerText, draft.Category, draft.Difficulty, questionID, authorID, draft.Language)
+}
+
+func (r *QuestionRepo) ListByStatus(ctx context.Context, status schema.QuestionStatus, page, pageSize int) (repository.ListQuestionsResult, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	offset := (page - 1) * pageSize
+
+	total, err := r.CountByStatus(ctx, status)
+	if err != nil {
+		return repository.ListQuestionsResult{}, err
+	}
+
+	const query = `
+	SELECT id::text, question_text, answer_text, category, difficulty, author_id, status, likes, dislikes, language, translation_group::text, COALESCE(pack_id::text, ''), created_at, updated_at
+	FROM questions
+	WHERE status = $1
+	ORDER BY created_at ASC
+	LIMIT $2 OFFSET $3;
+	`
+	rows, err := r.pool.Query(ctx, query, status, pageSize, offset)
+	if err != nil {
+		return repository.ListQuestionsResult{}, err
+	}
+	defer rows.Close()
+
+	items := make([]schema.Question, 0, pageSize)
+	for rows.Next() {
+		var q schema.Question
+		if err := rows.Scan(questionScanDest(&q)...); err != nil {
+			return repository.ListQuestionsResult{}, err
+		}
+		items = append(items, q)
+	}
+	if err := rows.Err(); err != nil {
+		return repository.ListQuestionsResult{}, err
+	}
+
+	return repository.ListQuestionsResult{Items: items, Total: total}, nil
+}
+
+func (r *QuestionRepo) CountByStatus(ctx context.Context, status schema.QuestionStatus) (int, error) {
+	const query = `SELECT COUNT(*) FROM questions WHERE status = $1;`
+	var cnt int
+	if err := r.pool.QueryRow(ctx, query, status).Scan(&cnt); err != nil {
+		return 0, err
+	}
+	return cnt, nil
+}
+
+func (r *QuestionRepo) CountByAuthorStatus(ctx context.Context, authorID int64, status schema.QuestionStatus) (int, error) {
+	const query = `SELECT COUNT(*) FROM questions WHERE author_id = $1 AND status = $2;`
+	var cnt int
+	if err := r.pool.QueryRow(ctx, query, authorID, status).Scan(&cnt); err != nil {
+		return 0, err
+	}
+	return cnt, nil
+}
+
+func (r *QuestionRepo) UpdatePending(ctx context.Context, editorID int64, questionID string, draft schema.QuestionDraft) (schema.Question, error) {
+	const query = `
+	UPDATE questions
+	SET question_text = $1,
+		answer_text = $2,
+		category = $3,
+		difficulty = $4,
+		language = $6,
+		updated_at = NOW()
+	WHERE id = $5 AND status = 'draft'
+	RETURNING id::text, question_text, answer_text, category, difficulty, author_id, status, likes, dislikes, language, translation_group::text, COALESCE(pack_id::text, ''), created_at, updated_at;
+	`
+
+	return r.updateWithRevision(ctx, questionID, editorID, "", errorz.ErrNotFound, query, draft.QuestionText, draft.AnswerText, draft.Category, draft.Difficulty, questionID, draft.Language)
+}
+
+func (r *QuestionRepo) Moderate(ctx context.Context, questionID string, status schema.QuestionStatus, moderatorID int64, reason string) (schema.Question, error) {
+	const query = `
+	UPDATE questions
+	SET status = $2,
+		moderated_by = $3,
+		moderated_at = NOW(),
+		reject_reason = $4,
+		updated_at = NOW()
+	WHERE id = $1 AND status = 'draft'
+	RETURNING id::text, question_text, answer_text, category, difficulty, author_id, status, likes, dislikes, language, translation_group::text, COALESCE(pack_id::text, ''), created_at, updated_at;
+	`
+
+	var out schema.Question
+	if err := r.pool.QueryRow(ctx, query, questionID, status, moderatorID, reason).Scan(questionScanDest(&out)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return schema.Question{}, errorz.ErrNotFound
+		}
+		return schema.Question{}, err
+	}
+	return out, nil
+}
+
+func (r *QuestionRepo) UpdateReviewed(ctx context.Context, editorID int64, questionID string, draft schema.QuestionDraft) (schema.Question, error) {
+	const query = `
+	UPDATE questions
+	SET question_text = $1,
+		answer_text = $2,
+		category = $3,
+		difficulty = $4,
+		language = $6,
+		status = 'active',
+		updated_at = NOW()
+	WHERE id = $5 AND status IN ('active', 'hidden')
+	RETURNING id::text, question_text, answer_text, category, difficulty, author_id, status, likes, dislikes, language, translation_group::text, COALESCE(pack_id::text, ''), created_at, updated_at;
+	`
+
+	return r.updateWithRevision(ctx, questionID, editorID, "", errorz.ErrNotFound, query, draft.QuestionText, draft.AnswerText, draft.Category, draft.Difficulty, questionID, draft.Language)
+}
+
+func (r *QuestionRepo) SetReviewedStatus(ctx context.Context, questionID string, status schema.QuestionStatus) error {
+	const query = `
+	UPDATE questions
+	SET status = $2,
+		deleted_at = CASE WHEN $2 = 'deleted' THEN NOW() END,
+		deleted_by = NULL,
+		updated_at = NOW()
+	WHERE id = $1 AND status IN ('active', 'hidden');
+	`
+	tag, err := r.pool.Exec(ctx, query, questionID, status)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errorz.ErrNotFound
+	}
+	return nil
+}
+
+func (r *QuestionRepo) SoftDeleteByAuthor(ctx context.Context, authorID int64, questionID string) error {
+	const query = `
+	UPDATE questions
+	SET status = 'deleted', deleted_at = NOW(), deleted_by = author_id, updated_at = NOW()
+	WHERE id = $1 AND author_id = $2 AND status = 'active';
+	`
+	tag, err := r.pool.Exec(ctx, query, questionID, authorID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errorz.ErrForbidden
+	}
+	return nil
+}
+
+func (r *QuestionRepo) ListManaged(ctx context.Context, authorID int64, page, pageSize int) (repository.ListQuestionsResult, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	offset := (page - 1) * pageSize
+
+	const countQuery = `
+	SELECT COUNT(*)
+	FROM questions
+	WHERE status IN ('active', 'hidden') AND ($1::bigint = 0 OR author_id = $1);
+	`
+	var total int
+	if err := r.pool.QueryRow(ctx, countQuery, authorID).Scan(&total); err != nil {
+		return repository.ListQuestionsResult{}, err
+	}
+
+	const query = `
+	SELECT id::text, question_text, answer_text, category, difficulty, author_id, status, likes, dislikes, language, translation_group::text, COALESCE(pack_id::text, ''), created_at, updated_at
+	FROM questions
+	WHERE status IN ('active', 'hidden') AND ($1::bigint = 0 OR author_id = $1)
+	ORDER BY created_at DESC
+	LIMIT $2 OFFSET $3;
+	`
+	rows, err := r.pool.Query(ctx, query, authorID, pageSize, offset)
+	if err != nil {
+		return repository.ListQuestionsResult{}, err
+	}
+	defer rows.Close()
+
+	items := make([]schema.Question, 0, pageSize)
+	for rows.Next() {
+		var q schema.Question
+		if err := rows.Scan(questionScanDest(&q)...); err != nil {
+			return repository.ListQuestionsResult{}, err
+		}
+		items = append(items, q)
+	}
+	if err := rows.Err(); err != nil {
+		return repository.ListQuestionsResult{}, err
+	}
+
+	return repository.ListQuestionsResult{Items: items, Total: total}, nil
+}
+
+func (r *QuestionRepo) ListAuthors(ctx context.Context, page, pageSize int) (schema.ListAuthorsResult, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	offset := (page - 1) * pageSize
+
+	const countQuery = `SELECT COUNT(DISTINCT author_id) FROM questions WHERE status IN ('active', 'hidden');`
+	var total int
+	if err := r.pool.QueryRow(ctx, countQuery).Scan(&total); err != nil {
+		return schema.ListAuthorsResult{}, err
+	}
+
+	const query = `
+	SELECT author_id, COUNT(*)
+	FROM questions
+	WHERE status IN ('active', 'hidden')
+	GROUP BY author_id
+	ORDER BY COUNT(*) DESC, author_id
+	LIMIT $1 OFFSET $2;
+	`
+	rows, err := r.pool.Query(ctx, query, pageSize, offset)
+	if err != nil {
+		return schema.ListAuthorsResult{}, err
+	}
+	defer rows.Close()
+
+	items := make([]schema.AuthorStat, 0, pageSize)
+	for rows.Next() {
+		var a schema.AuthorStat
+		if err := rows.Scan(&a.AuthorID, &a.Questions); err != nil {
+			return schema.ListAuthorsResult{}, err
+		}
+		items = append(items, a)
+	}
+	if err := rows.Err(); err != nil {
+		return schema.ListAuthorsResult{}, err
+	}
+
+	return schema.ListAuthorsResult{Items: items, Total: total}, nil
+}
+
+func (r *QuestionRepo) UpdateManaged(ctx context.Context, editorID int64, questionID string, draft schema.QuestionDraft) (schema.Question, error) {
+	const query = `
+	UPDATE questions
+	SET question_text = $1,
+		answer_text = $2,
+		category = $3,
+		difficulty = $4,
+		language = $6,
+		updated_at = NOW()
+	WHERE id = $5 AND status IN ('active', 'hidden')
+	RETURNING id::text, question_text, answer_text, category, difficulty, author_id, status, likes, dislikes, language, translation_group::text, COALESCE(pack_id::text, ''), created_at, updated_at;
+	`
+
+	return r.updateWithRevision(ctx, questionID, editorID, schema.AuditActionEdit, errorz.ErrNotFound, query, draft.QuestionText, draft.AnswerText, draft.Category, draft.Difficulty, questionID, draft.Language)
+}
+
+func (r *QuestionRepo) SoftDeleteManaged(ctx context.Context, actorID int64, questionID string) (schema.Question, error) {
+	const query = `
+	UPDATE questions
+	SET status = 'deleted', deleted_at = NOW(), deleted_by = $2, updated_at = NOW()
+	WHERE id = $1 AND status IN ('active', 'hidden')
+	RETURNING id::text, question_text, answer_text, category, difficulty, author_id, status, likes, dislikes, language, translation_group::text, COALESCE(pack_id::text, ''), created_at, updated_at;
+	`
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return schema.Question{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var out schema.Question
+	if err := tx.QueryRow(ctx, query, questionID, actorID).Scan(questionScanDest(&out)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return schema.Question{}, errorz.ErrNotFound
+		}
+		return schema.Question{}, err
+	}
+	if err := recordAudit(ctx, tx, schema.AuditEntry{
+		QuestionID:   out.ID,
+		ActorID:      actorID,
+		Action:       schema.AuditActionDelete,
+		FromAuthorID: out.AuthorID,
+	}); err != nil {
+		return schema.Question{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return schema.Question{}, err
+	}
+	return out, nil
+}
+
+func (r *QuestionRepo) ReassignAuthor(ctx context.Context, actorID int64, questionID string, authorID int64) (schema.Question, error) {
+	const lockQuery = `SELECT author_id FROM questions WHERE id = $1 AND status IN ('active', 'hidden') FOR UPDATE;`
+	const userQuery = `SELECT EXISTS (SELECT 1 FROM bot_users WHERE user_id = $1);`
+	const updateQuery = `
+	UPDATE questions
+	SET author_id = $2, updated_at = NOW()
+	WHERE id = $1
+	RETURNING id::text, question_text, answer_text, category, difficulty, author_id, status, likes, dislikes, language, translation_group::text, COALESCE(pack_id::text, ''), created_at, updated_at;
+	`
+	const seenQuery = `
+	INSERT INTO user_seen_questions (user_id, question_id, translation_group)
+	SELECT $1::bigint, id, translation_group FROM questions WHERE id = $2
+	ON CONFLICT (user_id, question_id) DO NOTHING;
+	`
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return schema.Question{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var prevAuthorID int64
+	if err := tx.QueryRow(ctx, lockQuery, questionID).Scan(&prevAuthorID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return schema.Question{}, errorz.ErrNotFound
+		}
+		return schema.Question{}, err
+	}
+	if prevAuthorID == authorID {
+		return schema.Question{}, errorz.ErrConflict
+	}
+	var known bool
+	if err := tx.QueryRow(ctx, userQuery, authorID).Scan(&known); err != nil {
+		return schema.Question{}, err
+	}
+	if !known {
+		return schema.Question{}, errorz.ErrInvalid
+	}
+	var out schema.Question
+	if err := tx.QueryRow(ctx, updateQuery, questionID, authorID).Scan(questionScanDest(&out)...); err != nil {
+		return schema.Question{}, err
+	}
+	if _, err := tx.Exec(ctx, seenQuery, authorID, questionID); err != nil {
+		return schema.Question{}, err
+	}
+	if err := recordAudit(ctx, tx, schema.AuditEntry{
+		QuestionID:   out.ID,
+		ActorID:      actorID,
+		Action:       schema.AuditActionReassign,
+		FromAuthorID: prevAuthorID,
+		ToAuthorID:   authorID,
+	}); err != nil {
+		return schema.Question{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return schema.Question{}, err
+	}
+	return out, nil
+}
+
+func (r *QuestionRepo) ListDeletedBy(ctx context.Context, userID int64, page, pageSize int) (repository.ListDeletedResult, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	offset := (page - 1) * pageSize
+
+	const countQuery = `SELECT COUNT(*) FROM questions WHERE status = 'deleted' AND deleted_by = $1;`
+	var total int
+	if err := r.pool.QueryRow(ctx, countQuery, userID).Scan(&total); err != nil {
+		return repository.ListDeletedResult{}, err
+	}
+
+	const query = `
+	SELECT id::text, question_text, answer_text, category, difficulty, author_id, status, likes, dislikes, language, translation_group::text, COALESCE(pack_id::text, ''), created_at, updated_at, deleted_at
+	FROM questions
+	WHERE status = 'deleted' AND deleted_by = $1
+	ORDER BY deleted_at DESC
+	LIMIT $2 OFFSET $3;
+	`
+	rows, err := r.pool.Query(ctx, query, userID, pageSize, offset)
+	if err != nil {
+		return repository.ListDeletedResult{}, err
+	}
+	defer rows.Close()
+
+	items := make([]schema.DeletedQuestion, 0, pageSize)
+	for rows.Next() {
+		var q schema.DeletedQuestion
+		if err := rows.Scan(append(questionScanDest(&q.Question), &q.DeletedAt)...); err != nil {
+			return repository.ListDeletedResult{}, err
+		}
+		items = append(items, q)
+	}
+	if err := rows.Err(); err != nil {
+		return repository.ListDeletedResult{}, err
+	}
+
+	return repository.ListDeletedResult{Items: items, Total: total}, nil
+}
+
+func (r *QuestionRepo) GetDeletedBy(ctx context.Context, userID int64, questionID string) (schema.DeletedQuestion, error) {
+	const query = `
+	SELECT id::text, question_text, answer_text, category, difficulty, author_id, status, likes, dislikes, language, translation_group::text, COALESCE(pack_id::text, ''), created_at, updated_at, deleted_at
+	FROM questions
+	WHERE id = $1 AND status = 'deleted' AND deleted_by = $2;
+	`
+	var out schema.DeletedQuestion
+	if err := r.pool.QueryRow(ctx, query, questionID, userID).Scan(append(questionScanDest(&out.Question), &out.DeletedAt)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return schema.DeletedQuestion{}, errorz.ErrNotFound
+		}
+		return schema.DeletedQuestion{}, err
+	}
+	return out, nil
+}
+
+func (r *QuestionRepo) RestoreDeletedBy(ctx context.Context, userID int64, questionID string) error {
+	const query = `
+	UPDATE questions
+	SET status = 'active', deleted_at = NULL, deleted_by = NULL, updated_at = NOW()
+	WHERE id = $1 AND status = 'deleted' AND deleted_by = $2;
+	`
+	tag, err := r.pool.Exec(ctx, query, questionID, userID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errorz.ErrNotFound
+	}
+	return nil
+}
+
+func (r *QuestionRepo) PurgeDeletedBy(ctx context.Context, userID int64, questionID string) error {
+	const query = `
+	SELECT id
+	FROM questions
+	WHERE id = $1 AND status = 'deleted' AND deleted_by = $2
+	FOR UPDATE;
+	`
+	purged, err := r.purge(ctx, query, questionID, userID)
+	if err != nil {
+		return err
+	}
+	if purged == 0 {
+		return errorz.ErrNotFound
+	}
+	return nil
+}
+
+func (r *QuestionRepo) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	const query = `
+	SELECT id
+	FROM questions
+	WHERE status = 'deleted' AND deleted_at < $1
+	FOR UPDATE SKIP LOCKED;
+	`
+	return r.purge(ctx, query, before)
+}
+
+func (r *QuestionRepo) purge(ctx context.Context, selectQuery string, args ...any) (int, error) {
+	cleanup := []string{
+		`DELETE FROM user_seen_questions WHERE question_id = ANY($1);`,
+		`DELETE FROM user_seen_questions_archive WHERE question_id = ANY($1);`,
+		`DELETE FROM team_seen_questions WHERE question_id = ANY($1);`,
+		`DELETE FROM team_seen_questions_archive WHERE question_id = ANY($1);`,
+		`DELETE FROM chat_seen_questions WHERE question_id = ANY($1);`,
+		`DELETE FROM user_answered_questions WHERE question_id = ANY($1);`,
+		`DELETE FROM question_revisions WHERE question_id = ANY($1);`,
+		`DELETE FROM question_ratings WHERE question_id = ANY($1);`,
+		`DELETE FROM question_reports WHERE question_id = ANY($1);`,
+		`DELETE FROM questions WHERE id = ANY($1);`,
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, selectQuery, args...)
+	if err != nil {
+		return 0, err
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[pgtype.UUID])
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	for _, q := range cleanup {
+		if _, err := tx.Exec(ctx, q, ids); err != nil {
+			return 0, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return len(ids), nil
+}
+
+func keepOnQuestionPurge(table string) string {
+	return fmt.Sprintf(`DO $$
+	BEGIN
+		IF EXISTS (
+			SELECT 1 FROM pg_constraint
+			WHERE conrelid = '%[1]s'::regclass AND conname = '%[1]s_question_id_fkey' AND confdeltype <> 'n'
+		) THEN
+			ALTER TABLE %[1]s DROP CONSTRAINT %[1]s_question_id_fkey;
+			ALTER TABLE %[1]s ADD CONSTRAINT %[1]s_question_id_fkey FOREIGN KEY (question_id) REFERENCES questions(id) ON DELETE SET NULL;
+		END IF;
+	END $$;`, table)
+}
+
+func rekeyByID(table string) string {
+	return fmt.Sprintf(`DO $$
+	BEGIN
+		IF EXISTS (
+			SELECT 1
+			FROM pg_constraint c
+			JOIN pg_attribute a ON a.attrelid = c.conrelid AND a.attnum = ANY(c.conkey)
+			WHERE c.conrelid = '%[1]s'::regclass AND c.contype = 'p' AND a.attname = 'question_id'
+		) THEN
+			ALTER TABLE %[1]s DROP CONSTRAINT %[1]s_pkey;
+			ALTER TABLE %[1]s ADD PRIMARY KEY (id);
+		END IF;
+	END $$;`, table)
+}
+
+func (r *QuestionRepo) updateWithRevision(ctx context.Context, questionID string, editorID int64, audit schema.AuditAction, notFound error, query string, args ...any) (schema.Question, error) {
+	const baselineQuery = `
+	INSERT INTO question_revisions (question_id, question_text, answer_text, category, difficulty, language, edited_by, created_at)
+	SELECT id, question_text, answer_text, category, difficulty, language, author_id, updated_at
+	FROM questions
+	WHERE id = $1 AND NOT EXISTS (SELECT 1 FROM question_revisions WHERE question_id = $1);
+	`
+	const revisionQuery = `
+	INSERT INTO question_revisions (question_id, question_text, answer_text, category, difficulty, language, edited_by, created_at)
+	SELECT q.id, q.question_text, q.answer_text, q.category, q.difficulty, q.language, $2, q.updated_at
+	FROM questions q
+	WHERE q.id = $1 AND NOT EXISTS (
+		SELECT 1
+		FROM (
+			SELECT question_text, answer_text, category, difficulty, language
+			FROM question_revisions
+			WHERE question_id = $1
+			ORDER BY id DESC
+			LIMIT 1
+		) last
+		WHERE last.question_text = q.question_text
+			AND last.answer_text = q.answer_text
+			AND last.category = q.category
+			AND last.difficulty = q.difficulty
+			AND last.language = q.language
+	);
+	`
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return schema.Question{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, baselineQuery, questionID); err != nil {
+		return schema.Question{}, err
+	}
+	var out schema.Question
+	if err := tx.QueryRow(ctx, query, args...).Scan(questionScanDest(&out)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return schema.Question{}, notFound
+		}
+		return schema.Question{}, err
+	}
+	if _, err := tx.Exec(ctx, revisionQuery, questionID, editorID); err != nil {
+		return schema.Question{}, err
+	}
+	if audit != "" {
+		if err := recordAudit(ctx, tx, schema.AuditEntry{
+			QuestionID:   out.ID,
+			ActorID:      editorID,
+			Action:       audit,
+			FromAuthorID: out.AuthorID,
+		}); err != nil {
+			return schema.Question{}, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return schema.Question{}, err
+	}
+	return out, nil
+}
+
+func (r *QuestionRepo) ListRevisions(ctx context.Context, questionID string) ([]schema.QuestionRevision, error) {
+	const query = `
+	SELECT id, question_id::text, question_text, answer_text, category, difficulty, language, edited_by, created_at
+	FROM question_revisions
+	WHERE question_id = $1
+	ORDER BY id;
+	`
+	rows, err := r.pool.Query(ctx, query, questionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []schema.QuestionRevision
+	for rows.Next() {
+		var rev schema.QuestionRevision
+		if err := rows.Scan(&rev.ID, &rev.QuestionID, &rev.QuestionText, &rev.AnswerText, &rev.Category, &rev.Difficulty, &rev.Language, &rev.EditedBy, &rev.CreatedAt); err != nil {
+			return nil, err
+		}
+		rev.Number = len(out) + 1
+		out = append(out, rev)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func questionScanDest(q *schema.Question) []any {
+	return []any{
+		&q.ID,
+		&q.QuestionText,
+		&q.AnswerText,
+		&q.Category,
+		&q.Difficulty,
+		&q.AuthorID,
+		&q.Status,
+		&q.Likes,
+		&q.Dislikes,
+		&q.Language,
+		&q.TranslationGroup,
+		&q.PackID,
+		&q.CreatedAt,
+		&q.UpdatedAt,
 	}
-	return nil
 }
@@ -0,0 +1,410 @@
+package postgres
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	testTeamID = "00000000-0000-0000-0000-0000000000aa"
+	testUserID = int64(42)
+	seedAuthor = int64(1)
+
+	benchQuestions  = 200000
+	benchSampleSize = 16
+)
+
+const orderByRandomUserQuery = `
+SELECT q.id::text
+FROM questions q
+WHERE q.status = 'active'
+  AND q.author_id <> $1
+  AND NOT EXISTS (
+	SELECT 1
+	FROM user_seen_questions usq
+	WHERE usq.user_id = $1 AND usq.question_id = q.id
+)
+ORDER BY RANDOM()
+LIMIT 1;
+`
+
+const orderByRandomTeamQuery = `
+SELECT q.id::text
+FROM questions q
+WHERE q.status = 'active'
+  AND q.author_id <> $2
+  AND NOT EXISTS (
+	SELECT 1
+	FROM team_seen_questions tsq
+	WHERE tsq.team_id = $1 AND tsq.question_id = q.id
+)
+ORDER BY RANDOM()
+LIMIT 1;
+`
+
+func openTestPool(tb testing.TB) *pgxpool.Pool {
+	tb.Helper()
+	dsn := os.Getenv("POSTGRES_DSN")
+	if dsn == "" {
+		tb.Skip("POSTGRES_DSN is not set")
+	}
+	ctx := context.Background()
+	schemaName := fmt.Sprintf("test_%d", time.Now().UnixNano())
+
+	admin, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		tb.Fatalf("connect postgres: %v", err)
+	}
+	if _, err := admin.Exec(ctx, `CREATE SCHEMA `+schemaName+`;`); err != nil {
+		admin.Close()
+		tb.Fatalf("create schema: %v", err)
+	}
+
+	cfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		tb.Fatalf("parse dsn: %v", err)
+	}
+	cfg.ConnConfig.RuntimeParams["search_path"] = schemaName + ", public"
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		tb.Fatalf("connect postgres: %v", err)
+	}
+	tb.Cleanup(func() {
+		pool.Close()
+		if _, err := admin.Exec(ctx, `DROP SCHEMA `+schemaName+` CASCADE;`); err != nil {
+			tb.Errorf("drop schema: %v", err)
+		}
+		admin.Close()
+	})
+
+	if err := NewQuestionRepo(pool).Migrate(ctx); err != nil {
+		tb.Fatalf("migrate questions: %v", err)
+	}
+	if err := NewPackRepo(pool).Migrate(ctx); err != nil {
+		tb.Fatalf("migrate packs: %v", err)
+	}
+	if err := NewAuditRepo(pool).Migrate(ctx); err != nil {
+		tb.Fatalf("migrate audit: %v", err)
+	}
+	if err := NewUserRepo(pool).Migrate(ctx); err != nil {
+		tb.Fatalf("migrate users: %v", err)
+	}
+	if err := NewRatingRepo(pool).Migrate(ctx); err != nil {
+		tb.Fatalf("migrate ratings: %v", err)
+	}
+	if err := NewReportRepo(pool).Migrate(ctx); err != nil {
+		tb.Fatalf("migrate reports: %v", err)
+	}
+	return pool
+}
+
+func seedQuestions(tb testing.TB, pool *pgxpool.Pool, n int) {
+	tb.Helper()
+	const query = `
+	INSERT INTO questions (id, translation_group, question_text, answer_text, author_id, category, difficulty, rand_key)
+	SELECT s.id, s.id, 'question ' || s.g, 'answer ' || s.g, 1,
+		(ARRAY['history','movies','science','geography','sport','music','literature','other'])[1 + s.g % 8],
+		(ARRAY['easy','medium','hard'])[1 + s.g % 3],
+		((s.g::bigint * 7919) % 100003)::float8 / 100003
+	FROM (SELECT gen_random_uuid() AS id, g FROM generate_series(1, $1::int) g) s;
+	`
+	if _, err := pool.Exec(context.Background(), query, n); err != nil {
+		tb.Fatalf("seed questions: %v", err)
+	}
+}
+
+func seededIDs(tb testing.TB, pool *pgxpool.Pool) []string {
+	tb.Helper()
+	rows, err := pool.Query(context.Background(), `SELECT id::text FROM questions ORDER BY split_part(question_text, ' ', 2)::int;`)
+	if err != nil {
+		tb.Fatalf("list questions: %v", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		tb.Fatalf("list questions: %v", err)
+	}
+	return ids
+}
+
+func firstCandidate(candidates []schema.Question) schema.Question {
+	return candidates[0]
+}
+
+func drawConcurrently(t *testing.T, workers, draws int, draw func(worker int) (schema.Question, error)) {
+	t.Helper()
+	var (
+		mu    sync.Mutex
+		drawn = make(map[string]int, workers*draws)
+		wg    sync.WaitGroup
+	)
+	start := make(chan struct{})
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			<-start
+			for i := 0; i < draws; i++ {
+				q, err := draw(worker)
+				if err != nil {
+					t.Errorf("worker %d draw %d: %v", worker, i, err)
+					return
+				}
+				mu.Lock()
+				drawn[q.ID]++
+				mu.Unlock()
+			}
+		}(w)
+	}
+	close(start)
+	wg.Wait()
+
+	for id, cnt := range drawn {
+		if cnt > 1 {
+			t.Errorf("question %s drawn %d times", id, cnt)
+		}
+	}
+}
+
+func TestDrawUnseenByTeamConcurrent(t *testing.T) {
+	pool := openTestPool(t)
+	seedQuestions(t, pool, 300)
+	repo := NewQuestionRepo(pool)
+	ctx := context.Background()
+
+	drawConcurrently(t, 8, 25, func(worker int) (schema.Question, error) {
+		return repo.DrawUnseenByTeam(ctx, testTeamID, int64(100+worker), schema.QuestionFilter{}, 16, firstCandidate)
+	})
+}
+
+func TestDrawUnseenByUserConcurrent(t *testing.T) {
+	pool := openTestPool(t)
+	seedQuestions(t, pool, 300)
+	repo := NewQuestionRepo(pool)
+	ctx := context.Background()
+
+	drawConcurrently(t, 8, 25, func(int) (schema.Question, error) {
+		return repo.DrawUnseenByUser(ctx, testUserID, schema.QuestionFilter{}, 16, firstCandidate)
+	})
+}
+
+func trashIDs(t *testing.T, repo *QuestionRepo, userID int64) []string {
+	t.Helper()
+	res, err := repo.ListDeletedBy(context.Background(), userID, 1, 10)
+	if err != nil {
+		t.Fatalf("list trash of %d: %v", userID, err)
+	}
+	ids := make([]string, 0, len(res.Items))
+	for _, q := range res.Items {
+		ids = append(ids, q.ID)
+	}
+	return ids
+}
+
+func TestAuthorDeleteGoesToAuthorTrash(t *testing.T) {
+	pool := openTestPool(t)
+	seedQuestions(t, pool, 1)
+	repo := NewQuestionRepo(pool)
+	ctx := context.Background()
+	id := seededIDs(t, pool)[0]
+
+	if err := repo.SoftDeleteByAuthor(ctx, seedAuthor, id); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if got := trashIDs(t, repo, seedAuthor); len(got) != 1 || got[0] != id {
+		t.Fatalf("author trash = %v, want [%s]", got, id)
+	}
+	if err := repo.RestoreDeletedBy(ctx, seedAuthor, id); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+}
+
+func TestManagedDeleteGoesToActorTrash(t *testing.T) {
+	pool := openTestPool(t)
+	seedQuestions(t, pool, 1)
+	repo := NewQuestionRepo(pool)
+	ctx := context.Background()
+	id := seededIDs(t, pool)[0]
+
+	if _, err := repo.SoftDeleteManaged(ctx, testActorID, id); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if got := trashIDs(t, repo, seedAuthor); len(got) != 0 {
+		t.Errorf("author trash = %v, want empty", got)
+	}
+	if err := repo.RestoreDeletedBy(ctx, seedAuthor, id); !errors.Is(err, errorz.ErrNotFound) {
+		t.Errorf("author restore error = %v, want ErrNotFound", err)
+	}
+	if got := trashIDs(t, repo, testActorID); len(got) != 1 || got[0] != id {
+		t.Fatalf("actor trash = %v, want [%s]", got, id)
+	}
+	if err := repo.RestoreDeletedBy(ctx, testActorID, id); err != nil {
+		t.Fatalf("actor restore: %v", err)
+	}
+}
+
+func TestReportDeactivationIsNotRestorable(t *testing.T) {
+	pool := openTestPool(t)
+	seedQuestions(t, pool, 1)
+	repo := NewQuestionRepo(pool)
+	ctx := context.Background()
+	id := seededIDs(t, pool)[0]
+
+	if err := repo.SetReviewedStatus(ctx, id, schema.QuestionStatusDeleted); err != nil {
+		t.Fatalf("deactivate: %v", err)
+	}
+	if got := trashIDs(t, repo, seedAuthor); len(got) != 0 {
+		t.Errorf("author trash = %v, want empty", got)
+	}
+	if err := repo.RestoreDeletedBy(ctx, seedAuthor, id); !errors.Is(err, errorz.ErrNotFound) {
+		t.Errorf("author restore error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestPurgeDeletedBeforeCoversEveryDeletionPath(t *testing.T) {
+	pool := openTestPool(t)
+	seedQuestions(t, pool, 4)
+	repo := NewQuestionRepo(pool)
+	ctx := context.Background()
+	ids := seededIDs(t, pool)
+
+	if err := repo.SoftDeleteByAuthor(ctx, seedAuthor, ids[0]); err != nil {
+		t.Fatalf("author delete: %v", err)
+	}
+	if _, err := repo.SoftDeleteManaged(ctx, testActorID, ids[1]); err != nil {
+		t.Fatalf("managed delete: %v", err)
+	}
+	if err := repo.SetReviewedStatus(ctx, ids[2], schema.QuestionStatusDeleted); err != nil {
+		t.Fatalf("deactivate: %v", err)
+	}
+	if err := repo.SoftDeleteByAuthor(ctx, seedAuthor, ids[3]); err != nil {
+		t.Fatalf("recent delete: %v", err)
+	}
+	const backdate = `UPDATE questions SET deleted_at = NOW() - INTERVAL '2 days' WHERE id = ANY($1::uuid[]);`
+	if _, err := pool.Exec(ctx, backdate, ids[:3]); err != nil {
+		t.Fatalf("backdate: %v", err)
+	}
+
+	purged, err := repo.PurgeDeletedBefore(ctx, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	if purged != 3 {
+		t.Errorf("purged = %d, want 3", purged)
+	}
+	if left := seededIDs(t, pool); len(left) != 1 || left[0] != ids[3] {
+		t.Errorf("remaining = %v, want [%s]", left, ids[3])
+	}
+}
+
+func openBenchPool(b *testing.B) *pgxpool.Pool {
+	b.Helper()
+	pool := openTestPool(b)
+	seedQuestions(b, pool, benchQuestions)
+	ctx := context.Background()
+	const seenQuery = `
+	WITH seen AS (
+		SELECT id, translation_group FROM questions WHERE split_part(question_text, ' ', 2)::int % 10 <> 0
+	), users AS (
+		INSERT INTO user_seen_questions (user_id, question_id, translation_group)
+		SELECT $1::bigint, id, translation_group FROM seen
+	)
+	INSERT INTO team_seen_questions (team_id, question_id, translation_group)
+	SELECT $2::uuid, id, translation_group FROM seen;
+	`
+	if _, err := pool.Exec(ctx, seenQuery, testUserID, testTeamID); err != nil {
+		b.Fatalf("seed seen questions: %v", err)
+	}
+	for _, table := range []string{"questions", "user_seen_questions", "team_seen_questions"} {
+		if _, err := pool.Exec(ctx, `ANALYZE `+table+`;`); err != nil {
+			b.Fatalf("analyze %s: %v", table, err)
+		}
+	}
+	return pool
+}
+
+func benchmarkSample(b *testing.B, query string, sampleArgs func(difficulty string) []any) {
+	pool := openBenchPool(b)
+	ctx := context.Background()
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		b.Fatalf("begin: %v", err)
+	}
+	defer tx.Rollback(ctx)
+	difficulties := sampleDifficulties(schema.QuestionFilter{})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		candidates, err := sampleCandidates(ctx, tx, query, benchSampleSize, difficulties, sampleArgs)
+		if err != nil {
+			b.Fatalf("sample: %v", err)
+		}
+		if len(candidates) == 0 {
+			b.Fatal("sample is empty")
+		}
+	}
+}
+
+func benchmarkOrderByRandom(b *testing.B, query string, args ...any) {
+	pool := openBenchPool(b)
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var id string
+		if err := pool.QueryRow(ctx, query, args...).Scan(&id); err != nil {
+			b.Fatalf("select: %v", err)
+		}
+	}
+}
+
+func BenchmarkSampleUnseenByUser(b *testing.B) {
+	benchmarkSample(b, sampleUnseenByUserQuery, func(difficulty string) []any {
+		return []any{testUserID, []string{}, difficulty, rand.Float64(), benchSampleSize, string(schema.LanguageAuto), []string{}}
+	})
+}
+
+func BenchmarkSampleUnseenByTeam(b *testing.B) {
+	benchmarkSample(b, sampleUnseenByTeamQuery, func(difficulty string) []any {
+		return []any{testUserID, []string{}, difficulty, rand.Float64(), benchSampleSize, string(schema.LanguageAuto), []string{}, testTeamID}
+	})
+}
+
+func benchmarkCountUnseen(b *testing.B, query string, args ...any) {
+	pool := openBenchPool(b)
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var cnt int
+		if err := pool.QueryRow(ctx, query, args...).Scan(&cnt); err != nil {
+			b.Fatalf("count: %v", err)
+		}
+	}
+}
+
+func BenchmarkCountUnseenByUser(b *testing.B) {
+	benchmarkCountUnseen(b, countUnseenByUserQuery, testUserID, []string{}, "mixed", benchSampleSize, string(schema.LanguageAuto), []string{})
+}
+
+func BenchmarkCountUnseenByTeam(b *testing.B) {
+	benchmarkCountUnseen(b, countUnseenByTeamQuery, testUserID, []string{}, "mixed", benchSampleSize, string(schema.LanguageAuto), []string{}, testTeamID)
+}
+
+func BenchmarkOrderByRandomUser(b *testing.B) {
+	benchmarkOrderByRandom(b, orderByRandomUserQuery, testUserID)
+}
+
+func BenchmarkOrderByRandomTeam(b *testing.B) {
+	benchmarkOrderByRandom(b, orderByRandomTeamQuery, testTeamID, testUserID)
+}
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RatingRepo struct {
+	pool *pgxpool.Pool
+}
+
+var _ repository.RatingRepository = (*RatingRepo)(nil)
+
+func NewRatingRepo(pool *pgxpool.Pool) *RatingRepo {
+	return &RatingRepo{pool: pool}
+}
+
+func (r *RatingRepo) Migrate(ctx context.Context) error {
+	queries := []string{
+		`CREATE TABLE IF NOT EXISTS question_ratings (
+			question_id UUID NOT NULL REFERENCES questions(id),
+			user_id BIGINT NOT NULL,
+			value SMALLINT NOT NULL,
+			rated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY(question_id, user_id)
+		);`,
+	}
+
+	for _, q := range queries {
+		if _, err := r.pool.Exec(ctx, q); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *RatingRepo) Rate(ctx context.Context, rating schema.QuestionRating) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var id string
+	if err := tx.QueryRow(ctx, `SELECT id::text FROM questions WHERE id = $1 FOR UPDATE;`, rating.QuestionID).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errorz.ErrNotFound
+		}
+		return err
+	}
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO question_ratings (question_id, user_id, value)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (question_id, user_id) DO UPDATE
+		SET value = EXCLUDED.value, rated_at = NOW();
+	`, rating.QuestionID, rating.UserID, int(rating.Value)); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, `
+		UPDATE questions
+		SET likes = (SELECT COUNT(*) FROM question_ratings WHERE question_id = $1 AND value > 0),
+			dislikes = (SELECT COUNT(*) FROM question_ratings WHERE question_id = $1 AND value < 0)
+		WHERE id = $1;
+	`, rating.QuestionID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReportRepo struct {
+	pool *pgxpool.Pool
+}
+
+var _ repository.ReportRepository = (*ReportRepo)(nil)
+
+func NewReportRepo(pool *pgxpool.Pool) *ReportRepo {
+	return &ReportRepo{pool: pool}
+}
+
+func (r *ReportRepo) Migrate(ctx context.Context) error {
+	queries := []string{
+		`CREATE EXTENSION IF NOT EXISTS pgcrypto;`,
+		`CREATE TABLE IF NOT EXISTS question_reports (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			question_id UUID NOT NULL REFERENCES questions(id),
+			user_id BIGINT NOT NULL,
+			reason TEXT NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			resolved_at TIMESTAMPTZ,
+			resolved_by BIGINT,
+			resolution TEXT
+		);`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS uq_question_reports_open_user ON question_reports(question_id, user_id) WHERE resolved_at IS NULL;`,
+		`CREATE INDEX IF NOT EXISTS idx_question_reports_question ON question_reports(question_id);`,
+	}
+
+	for _, q := range queries {
+		if _, err := r.pool.Exec(ctx, q); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ReportRepo) Create(ctx context.Context, report schema.QuestionReport) error {
+	const query = `
+	INSERT INTO question_reports (question_id, user_id, reason)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (question_id, user_id) WHERE resolved_at IS NULL DO NOTHING;
+	`
+	tag, err := r.pool.Exec(ctx, query, report.QuestionID, report.UserID, report.Reason)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errorz.ErrAlreadyExists
+	}
+	return nil
+}
+
+func (r *ReportRepo) CountOpen(ctx context.Context, questionID string) (int, error) {
+	const query = `SELECT COUNT(*) FROM question_reports WHERE question_id = $1 AND resolved_at IS NULL;`
+	var cnt int
+	if err := r.pool.QueryRow(ctx, query, questionID).Scan(&cnt); err != nil {
+		return 0, err
+	}
+	return cnt, nil
+}
+
+const openReportsQuery = `
+	SELECT q.id::text, q.question_text, q.answer_text, q.category, q.difficulty, q.author_id, q.status, q.likes, q.dislikes, q.language, q.translation_group::text, COALESCE(q.pack_id::text, ''), q.created_at, q.updated_at,
+		rep.cnt, rep.reasons, rep.last_at
+	FROM (
+		SELECT question_id, COUNT(*) AS cnt, array_agg(reason) AS reasons, MAX(created_at) AS last_at
+		FROM question_reports
+		WHERE resolved_at IS NULL
+		GROUP BY question_id
+	) rep
+	INNER JOIN questions q ON q.id = rep.question_id
+	WHERE q.status IN ('active', 'hidden')
+`
+
+func (r *ReportRepo) ListOpen(ctx context.Context, page, pageSize int) (schema.ListReportedResult, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	offset := (page - 1) * pageSize
+
+	const countQuery = `
+	SELECT COUNT(DISTINCT r.question_id)
+	FROM question_reports r
+	INNER JOIN questions q ON q.id = r.question_id
+	WHERE r.resolved_at IS NULL AND q.status IN ('active', 'hidden');
+	`
+	var total int
+	if err := r.pool.QueryRow(ctx, countQuery).Scan(&total); err != nil {
+		return schema.ListReportedResult{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, openReportsQuery+`
+	ORDER BY rep.cnt DESC, rep.last_at DESC
+	LIMIT $1 OFFSET $2;
+	`, pageSize, offset)
+	if err != nil {
+		return schema.ListReportedResult{}, err
+	}
+	defer rows.Close()
+
+	items := make([]schema.ReportedQuestion, 0, pageSize)
+	for rows.Next() {
+		item, err := scanReportedQuestion(rows)
+		if err != nil {
+			return schema.ListReportedResult{}, err
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return schema.ListReportedResult{}, err
+	}
+	return schema.ListReportedResult{Items: items, Total: total}, nil
+}
+
+func (r *ReportRepo) GetOpen(ctx context.Context, questionID string) (schema.ReportedQuestion, error) {
+	out, err := scanReportedQuestion(r.pool.QueryRow(ctx, openReportsQuery+` AND q.id = $1;`, questionID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return schema.ReportedQuestion{}, errorz.ErrNotFound
+		}
+		return schema.ReportedQuestion{}, err
+	}
+	return out, nil
+}
+
+func (r *ReportRepo) ResolveOpen(ctx context.Context, questionID string, resolvedBy int64, resolution schema.ReportResolution) error {
+	const query = `
+	UPDATE question_reports
+	SET resolved_at = NOW(), resolved_by = $2, resolution = $3
+	WHERE question_id = $1 AND resolved_at IS NULL;
+	`
+	_, err := r.pool.Exec(ctx, query, questionID, resolvedBy, resolution)
+	return err
+}
+
+func scanReportedQuestion(row pgx.Row) (schema.ReportedQuestion, error) {
+	var (
+		out     schema.ReportedQuestion
+		reasons []string
+	)
+	dest := append(questionScanDest(&out.Question), &out.Reports, &reasons, &out.LastReportAt)
+	if err := row.Scan(dest...); err != nil {
+		return schema.ReportedQuestion{}, err
+	}
+	out.Reasons = make(map[schema.ReportReason]int, len(reasons))
+	for _, reason := range reasons {
+		out.Reasons[schema.ReportReason(reason)]++
+	}
+	return out, nil
+}
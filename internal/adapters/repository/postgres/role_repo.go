@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RoleRepo struct {
+	pool *pgxpool.Pool
+}
+
+var _ repository.RoleRepository = (*RoleRepo)(nil)
+
+func NewRoleRepo(pool *pgxpool.Pool) *RoleRepo {
+	return &RoleRepo{pool: pool}
+}
+
+func (r *RoleRepo) Migrate(ctx context.Context) error {
+	queries := []string{
+		`CREATE TABLE IF NOT EXISTS user_roles (
+			user_id BIGINT PRIMARY KEY,
+			role TEXT NOT NULL,
+			granted_by BIGINT NOT NULL DEFAULT 0,
+			granted_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		);`,
+		`CREATE INDEX IF NOT EXISTS idx_user_roles_role ON user_roles(role);`,
+		`CREATE TABLE IF NOT EXISTS user_role_imports (
+			source TEXT PRIMARY KEY,
+			imported_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		);`,
+	}
+
+	for _, q := range queries {
+		if _, err := r.pool.Exec(ctx, q); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *RoleRepo) List(ctx context.Context) ([]schema.UserRole, error) {
+	const query = `
+	SELECT user_id, role, granted_by, granted_at
+	FROM user_roles
+	ORDER BY granted_at, user_id;
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []schema.UserRole
+	for rows.Next() {
+		var role schema.UserRole
+		if err := rows.Scan(&role.UserID, &role.Role, &role.GrantedBy, &role.GrantedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, role)
+	}
+	return out, rows.Err()
+}
+
+func (r *RoleRepo) Set(ctx context.Context, role schema.UserRole) error {
+	const query = `
+	INSERT INTO user_roles (user_id, role, granted_by)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (user_id) DO UPDATE SET role = EXCLUDED.role, granted_by = EXCLUDED.granted_by, granted_at = NOW();
+	`
+	_, err := r.pool.Exec(ctx, query, role.UserID, role.Role, role.GrantedBy)
+	return err
+}
+
+func (r *RoleRepo) Delete(ctx context.Context, userID int64) error {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM user_roles WHERE user_id = $1;`, userID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errorz.ErrNotFound
+	}
+	return nil
+}
+
+func (r *RoleRepo) EnsureOwners(ctx context.Context, userIDs []int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	const query = `
+	INSERT INTO user_roles (user_id, role)
+	SELECT unnest($1::bigint[]), 'owner'
+	ON CONFLICT (user_id) DO UPDATE SET role = 'owner'
+	WHERE user_roles.role <> 'owner';
+	`
+	_, err := r.pool.Exec(ctx, query, userIDs)
+	return err
+}
+
+func (r *RoleRepo) ImportRoles(ctx context.Context, source string, userIDs []int64, role schema.Role) (int64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `INSERT INTO user_role_imports (source) VALUES ($1) ON CONFLICT (source) DO NOTHING;`, source)
+	if err != nil {
+		return 0, err
+	}
+	if tag.RowsAffected() == 0 {
+		return 0, errorz.ErrAlreadyExists
+	}
+	const query = `
+	INSERT INTO user_roles (user_id, role)
+	SELECT unnest($1::bigint[]), $2
+	ON CONFLICT (user_id) DO NOTHING;
+	`
+	tag, err = tx.Exec(ctx, query, userIDs, role)
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ScoreRepo struct {
+	pool *pgxpool.Pool
+}
+
+var _ repository.ScoreRepository = (*ScoreRepo)(nil)
+
+func NewScoreRepo(pool *pgxpool.Pool) *ScoreRepo {
+	return &ScoreRepo{pool: pool}
+}
+
+func (r *ScoreRepo) Migrate(ctx context.Context) error {
+	queries := []string{
+		`CREATE TABLE IF NOT EXISTS team_question_scores (
+			id BIGSERIAL PRIMARY KEY,
+			team_id UUID NOT NULL,
+			question_id UUID REFERENCES questions(id) ON DELETE SET NULL,
+			user_id BIGINT,
+			scored_by BIGINT NOT NULL,
+			scored_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			archived_at TIMESTAMPTZ
+		);`,
+		`ALTER TABLE team_question_scores ADD COLUMN IF NOT EXISTS id BIGSERIAL;`,
+		rekeyByID("team_question_scores"),
+		`ALTER TABLE team_question_scores ALTER COLUMN question_id DROP NOT NULL;`,
+		keepOnQuestionPurge("team_question_scores"),
+		`ALTER TABLE team_question_scores ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ;`,
+		`DROP INDEX IF EXISTS uq_team_question_scores_team_question;`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS uq_team_question_scores_open ON team_question_scores(team_id, question_id) WHERE archived_at IS NULL;`,
+		`CREATE INDEX IF NOT EXISTS idx_team_question_scores_user_id ON team_question_scores(user_id);`,
+	}
+
+	for _, q := range queries {
+		if _, err := r.pool.Exec(ctx, q); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ScoreRepo) RecordTeamScore(ctx context.Context, score schema.QuestionScore) error {
+	const query = `
+	INSERT INTO team_question_scores (team_id, question_id, user_id, scored_by)
+	VALUES ($1, $2, NULLIF($3::bigint, 0), $4)
+	ON CONFLICT (team_id, question_id) WHERE archived_at IS NULL DO NOTHING;
+	`
+	tag, err := r.pool.Exec(ctx, query, score.TeamID, score.QuestionID, score.UserID, score.ScoredBy)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errorz.ErrAlreadyExists
+	}
+	return nil
+}
+
+func (r *ScoreRepo) IsScoredByTeam(ctx context.Context, teamID string, questionID string) (bool, error) {
+	const query = `SELECT EXISTS (SELECT 1 FROM team_question_scores WHERE team_id = $1 AND question_id = $2 AND archived_at IS NULL);`
+	var ok bool
+	if err := r.pool.QueryRow(ctx, query, teamID, questionID).Scan(&ok); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+func (r *ScoreRepo) CountTeamScore(ctx context.Context, teamID string) (int, error) {
+	const query = `SELECT COUNT(*) FROM team_question_scores WHERE team_id = $1 AND user_id IS NOT NULL;`
+	var cnt int
+	if err := r.pool.QueryRow(ctx, query, teamID).Scan(&cnt); err != nil {
+		return 0, err
+	}
+	return cnt, nil
+}
+
+func (r *ScoreRepo) TeamMemberScores(ctx context.Context, teamID string) (map[int64]int, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT user_id, COUNT(*)
+		FROM team_question_scores
+		WHERE team_id = $1 AND user_id IS NOT NULL
+		GROUP BY user_id;
+	`, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make(map[int64]int)
+	for rows.Next() {
+		var (
+			userID int64
+			cnt    int
+		)
+		if err := rows.Scan(&userID, &cnt); err != nil {
+			return nil, err
+		}
+		out[userID] = cnt
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *ScoreRepo) CountUserScore(ctx context.Context, userID int64) (int, error) {
+	const query = `SELECT COUNT(*) FROM team_question_scores WHERE user_id = $1;`
+	var cnt int
+	if err := r.pool.QueryRow(ctx, query, userID).Scan(&cnt); err != nil {
+		return 0, err
+	}
+	return cnt, nil
+}
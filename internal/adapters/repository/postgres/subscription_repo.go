@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"LoudQuestionBot/internal/domain/repository"
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SubscriptionRepo struct {
+	pool *pgxpool.Pool
+}
+
+var _ repository.SubscriptionRepository = (*SubscriptionRepo)(nil)
+
+func NewSubscriptionRepo(pool *pgxpool.Pool) *SubscriptionRepo {
+	return &SubscriptionRepo{pool: pool}
+}
+
+func (r *SubscriptionRepo) Migrate(ctx context.Context) error {
+	queries := []string{
+		`CREATE TABLE IF NOT EXISTS new_question_subscriptions (
+			user_id BIGINT PRIMARY KEY,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		);`,
+	}
+
+	for _, q := range queries {
+		if _, err := r.pool.Exec(ctx, q); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *SubscriptionRepo) Subscribe(ctx context.Context, userID int64) error {
+	const query = `
+	INSERT INTO new_question_subscriptions (user_id)
+	VALUES ($1)
+	ON CONFLICT (user_id) DO NOTHING;
+	`
+	_, err := r.pool.Exec(ctx, query, userID)
+	return err
+}
+
+func (r *SubscriptionRepo) Unsubscribe(ctx context.Context, userID int64) error {
+	const query = `
+	DELETE FROM new_question_subscriptions
+	WHERE user_id = $1;
+	`
+	_, err := r.pool.Exec(ctx, query, userID)
+	return err
+}
+
+func (r *SubscriptionRepo) IsSubscribed(ctx context.Context, userID int64) (bool, error) {
+	const query = `
+	SELECT EXISTS (
+		SELECT 1
+		FROM new_question_subscriptions
+		WHERE user_id = $1
+	);
+	`
+	var ok bool
+	if err := r.pool.QueryRow(ctx, query, userID).Scan(&ok); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+func (r *SubscriptionRepo) ListSubscribers(ctx context.Context) ([]int64, error) {
+	const query = `
+	SELECT user_id
+	FROM new_question_subscriptions
+	ORDER BY created_at;
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -51,6 +51,9 @@ func (r *UserRepo) Migrate(ctx context.Context) error {
 		`ALTER TABLE bot_users DROP COLUMN IF EXISTS start_count;`,
 		`ALTER TABLE bot_users DROP COLUMN IF EXISTS first_started_at;`,
 		`ALTER TABLE bot_users DROP COLUMN IF EXISTS last_started_at;`,
+		`ALTER TABLE bot_users ADD COLUMN IF NOT EXISTS daily_question BOOLEAN NOT NULL DEFAULT FALSE;`,
+		`CREATE INDEX IF NOT EXISTS idx_bot_users_daily_question ON bot_users(user_id) WHERE daily_question;`,
+		`ALTER TABLE bot_users ADD COLUMN IF NOT EXISTS language TEXT NOT NULL DEFAULT '';`,
 	}
 
 	for _, q := range queries {
@@ -74,13 +77,13 @@ func (r *UserRepo) RegisterStart(ctx context.Context, user schema.BotUser) (sche
 	INSERT INTO bot_users (
 		user_id, first_name, last_name, username, language_code, is_bot
 	) VALUES ($1, $2, $3, $4, $5, $6)
-	RETURNING user_id, first_name, last_name, username, language_code, is_bot, registered_at, last_interaction_at;
+	RETURNING user_id, first_name, last_name, username, language_code, language, is_bot, daily_question, registered_at, last_interaction_at;
 	`
 	var out schema.BotUser
 	if err := r.pool.QueryRow(ctx, insertQuery,
 		user.UserID, user.FirstName, user.LastName, user.Username, user.LanguageCode, user.IsBot,
 	).Scan(
-		&out.UserID, &out.FirstName, &out.LastName, &out.Username, &out.LanguageCode, &out.IsBot,
+		&out.UserID, &out.FirstName, &out.LastName, &out.Username, &out.LanguageCode, &out.Language, &out.IsBot, &out.DailyQuestion,
 		&out.RegisteredAt, &out.LastInteractionAt,
 	); err != nil {
 		return schema.BotUser{}, false, err
@@ -90,13 +93,13 @@ func (r *UserRepo) RegisterStart(ctx context.Context, user schema.BotUser) (sche
 
 func (r *UserRepo) GetByID(ctx context.Context, userID int64) (schema.BotUser, bool, error) {
 	const query = `
-	SELECT user_id, first_name, last_name, username, language_code, is_bot, registered_at, last_interaction_at
+	SELECT user_id, first_name, last_name, username, language_code, language, is_bot, daily_question, registered_at, last_interaction_at
 	FROM bot_users
 	WHERE user_id = $1;
 	`
 	var out schema.BotUser
 	if err := r.pool.QueryRow(ctx, query, userID).Scan(
-		&out.UserID, &out.FirstName, &out.LastName, &out.Username, &out.LanguageCode, &out.IsBot,
+		&out.UserID, &out.FirstName, &out.LastName, &out.Username, &out.LanguageCode, &out.Language, &out.IsBot, &out.DailyQuestion,
 		&out.RegisteredAt, &out.LastInteractionAt,
 	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -111,3 +114,43 @@ func (r *UserRepo) TouchInteraction(ctx context.Context, userID int64) error {
 	_, err := r.pool.Exec(ctx, `UPDATE bot_users SET last_interaction_at = NOW() WHERE user_id = $1;`, userID)
 	return err
 }
+
+func (r *UserRepo) SetDailyQuestion(ctx context.Context, userID int64, enabled bool) error {
+	_, err := r.pool.Exec(ctx, `UPDATE bot_users SET daily_question = $2 WHERE user_id = $1;`, userID, enabled)
+	return err
+}
+
+func (r *UserRepo) SetLanguage(ctx context.Context, userID int64, lang schema.Language) error {
+	_, err := r.pool.Exec(ctx, `UPDATE bot_users SET language = $2 WHERE user_id = $1;`, userID, string(lang))
+	return err
+}
+
+func (r *UserRepo) ListDailyQuestionUsers(ctx context.Context) ([]schema.BotUser, error) {
+	const query = `
+	SELECT user_id, first_name, last_name, username, language_code, language, is_bot, daily_question, registered_at, last_interaction_at
+	FROM bot_users
+	WHERE daily_question AND NOT is_bot
+	ORDER BY user_id;
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []schema.BotUser
+	for rows.Next() {
+		var u schema.BotUser
+		if err := rows.Scan(
+			&u.UserID, &u.FirstName, &u.LastName, &u.Username, &u.LanguageCode, &u.Language, &u.IsBot, &u.DailyQuestion,
+			&u.RegisteredAt, &u.LastInteractionAt,
+		); err != nil {
+			return nil, err
+		}
+		out = append(out, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
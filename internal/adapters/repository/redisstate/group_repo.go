@@ -0,0 +1,54 @@
+package redisstate
+
+import (
+	"LoudQuestionBot/internal/domain/repository"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const groupHostTTL = 7 * 24 * time.Hour
+
+type GroupRepo struct {
+	client *redis.Client
+}
+
+var _ repository.GroupRepository = (*GroupRepo)(nil)
+
+func NewGroupRepo(client *redis.Client) *GroupRepo {
+	return &GroupRepo{client: client}
+}
+
+func (r *GroupRepo) GetHost(ctx context.Context, chatID int64) (int64, bool, error) {
+	v, err := r.client.Get(ctx, groupHostKey(chatID)).Result()
+	if err == redis.Nil {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	hostID, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+	return hostID, true, nil
+}
+
+func (r *GroupRepo) SetHost(ctx context.Context, chatID int64, userID int64) error {
+	return r.client.Set(ctx, groupHostKey(chatID), strconv.FormatInt(userID, 10), groupHostTTL).Err()
+}
+
+func (r *GroupRepo) SetHostIfAbsent(ctx context.Context, chatID int64, userID int64) (bool, error) {
+	return r.client.SetNX(ctx, groupHostKey(chatID), strconv.FormatInt(userID, 10), groupHostTTL).Result()
+}
+
+func (r *GroupRepo) DeleteHost(ctx context.Context, chatID int64) error {
+	return r.client.Del(ctx, groupHostKey(chatID)).Err()
+}
+
+func groupHostKey(chatID int64) string {
+	return fmt.Sprintf("group:host:%d", chatID)
+}
@@ -8,4 +8,6 @@ var (
 	ErrAlreadyExists = errors.New("already exists")
 	ErrConflict      = errors.New("conflict")
 	ErrLimitExceeded = errors.New("limit exceeded")
+	ErrInvalid       = errors.New("invalid")
+	ErrDuplicate     = errors.New("duplicate")
 )
@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+)
+
+type AuditRepository interface {
+	List(ctx context.Context, questionID string, page, pageSize int) (schema.ListAuditResult, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+)
+
+type BroadcastRepository interface {
+	CountAudience(ctx context.Context, draft schema.BroadcastDraft) (int, error)
+	Create(ctx context.Context, authorID int64, draft schema.BroadcastDraft) (schema.Broadcast, error)
+	NextSending(ctx context.Context) (schema.Broadcast, error)
+	PendingRecipients(ctx context.Context, broadcastID string, limit int) ([]int64, error)
+	SetRecipientStatus(ctx context.Context, broadcastID string, userID int64, status schema.RecipientStatus, errText string) error
+	Finish(ctx context.Context, broadcastID string) (schema.Broadcast, error)
+	ListRecent(ctx context.Context, limit int) ([]schema.Broadcast, error)
+}
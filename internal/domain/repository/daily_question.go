@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+)
+
+type DailyQuestionRepository interface {
+	CreateForDay(ctx context.Context, day string, language schema.Language) (schema.DailyQuestion, error)
+	GetByDay(ctx context.Context, day string, language schema.Language) (schema.DailyQuestion, error)
+	SaveAnswer(ctx context.Context, day string, language schema.Language, userID int64, knew bool) error
+	Summary(ctx context.Context, day string, language schema.Language) (schema.DailySummary, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+)
+
+type GameSessionRepository interface {
+	Create(ctx context.Context, s schema.GameSession) (schema.GameSession, error)
+	GetActiveByChat(ctx context.Context, chatID int64) (schema.GameSession, bool, error)
+	GetByID(ctx context.Context, sessionID string) (schema.GameSession, error)
+	ListFinishedByChat(ctx context.Context, chatID int64, limit int) ([]schema.GameSession, error)
+	AddRound(ctx context.Context, sessionID string, questionID string) error
+	MarkRoundRevealed(ctx context.Context, sessionID string, questionID string) error
+	SetRoundScorer(ctx context.Context, sessionID string, questionID string, userID int64) error
+	Finish(ctx context.Context, sessionID string) error
+}
@@ -0,0 +1,10 @@
+package repository
+
+import "context"
+
+type GroupRepository interface {
+	GetHost(ctx context.Context, chatID int64) (int64, bool, error)
+	SetHost(ctx context.Context, chatID int64, userID int64) error
+	SetHostIfAbsent(ctx context.Context, chatID int64, userID int64) (bool, error)
+	DeleteHost(ctx context.Context, chatID int64) error
+}
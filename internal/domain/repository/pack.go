@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+)
+
+type PackRepository interface {
+	Create(ctx context.Context, authorID int64, draft schema.PackDraft) (schema.Pack, error)
+	GetByID(ctx context.Context, id string) (schema.Pack, error)
+	List(ctx context.Context) ([]schema.Pack, error)
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+)
+
+type PlaySettingsRepository interface {
+	Get(ctx context.Context, scope schema.PlayScope) (schema.PlaySettings, error)
+	Save(ctx context.Context, scope schema.PlayScope, settings schema.PlaySettings) error
+}
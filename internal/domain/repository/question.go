@@ -3,6 +3,7 @@ package repository
 import (
 	"LoudQuestionBot/internal/domain/schema"
 	"context"
+	"time"
 )
 
 type ListQuestionsResult struct {
@@ -10,17 +11,55 @@ type ListQuestionsResult struct {
 	Total int
 }
 
+type ListDeletedResult struct {
+	Items []schema.DeletedQuestion
+	Total int
+}
+
+type QuestionPicker func(candidates []schema.Question) schema.Question
+
 type QuestionRepository interface {
 	Create(ctx context.Context, q schema.Question) (schema.Question, error)
+	CreateBatch(ctx context.Context, authorID int64, questions []schema.Question) (int, error)
 	GetByID(ctx context.Context, id string) (schema.Question, error)
-	GetActiveUnseenByUser(ctx context.Context, userID int64) (schema.Question, error)
-	GetActiveUnseenByTeam(ctx context.Context, teamID string, userID int64) (schema.Question, error)
+	ListTranslations(ctx context.Context, questionID string) ([]schema.Question, error)
+	DrawUnseenByUser(ctx context.Context, userID int64, filter schema.QuestionFilter, limit int, pick QuestionPicker) (schema.Question, error)
+	DrawUnseenByTeam(ctx context.Context, teamID string, userID int64, filter schema.QuestionFilter, limit int, pick QuestionPicker) (schema.Question, error)
+	DrawUnseenByChat(ctx context.Context, chatID int64, hostID int64, filter schema.QuestionFilter, limit int, pick QuestionPicker) (schema.Question, error)
+	CountUnseenByUser(ctx context.Context, userID int64, filter schema.QuestionFilter, limit int) (int, error)
+	CountUnseenByTeam(ctx context.Context, teamID string, userID int64, filter schema.QuestionFilter, limit int) (int, error)
 	MarkSeenByUser(ctx context.Context, userID int64, questionID string) error
-	MarkSeenByTeam(ctx context.Context, teamID string, questionID string) error
+	ResetSeenByUser(ctx context.Context, userID int64) (int, error)
+	ResetSeenByTeam(ctx context.Context, teamID string) (int, error)
+	ResetSeenByChat(ctx context.Context, chatID int64) (int, error)
+	IsSeenByTeam(ctx context.Context, teamID string, questionID string) (bool, error)
 	CountSeenByTeam(ctx context.Context, teamID string) (int, error)
 	MarkAnsweredByUser(ctx context.Context, userID int64, questionID string) error
 	CountAnsweredByUser(ctx context.Context, userID int64) (int, error)
 	ListByAuthor(ctx context.Context, authorID int64, page, pageSize int) (ListQuestionsResult, error)
+	FindSimilar(ctx context.Context, text string, language schema.Language, threshold float64, limit int) ([]schema.SimilarQuestion, error)
+	FindSimilarBatch(ctx context.Context, drafts []schema.QuestionDraft, threshold float64) (map[int]schema.SimilarQuestion, error)
+	ListSimilarPairs(ctx context.Context, threshold float64, limit int) ([]schema.DuplicatePair, error)
+	SearchByAuthor(ctx context.Context, authorID int64, query string, page, pageSize int) (ListQuestionsResult, error)
+	ExportByAuthor(ctx context.Context, authorID int64) ([]schema.QuestionExport, error)
 	UpdateByAuthor(ctx context.Context, authorID int64, questionID string, draft schema.QuestionDraft) (schema.Question, error)
+	ListByStatus(ctx context.Context, status schema.QuestionStatus, page, pageSize int) (ListQuestionsResult, error)
+	CountByStatus(ctx context.Context, status schema.QuestionStatus) (int, error)
+	CountByAuthorStatus(ctx context.Context, authorID int64, status schema.QuestionStatus) (int, error)
+	UpdatePending(ctx context.Context, editorID int64, questionID string, draft schema.QuestionDraft) (schema.Question, error)
+	Moderate(ctx context.Context, questionID string, status schema.QuestionStatus, moderatorID int64, reason string) (schema.Question, error)
+	UpdateReviewed(ctx context.Context, editorID int64, questionID string, draft schema.QuestionDraft) (schema.Question, error)
+	ListRevisions(ctx context.Context, questionID string) ([]schema.QuestionRevision, error)
+	SetReviewedStatus(ctx context.Context, questionID string, status schema.QuestionStatus) error
 	SoftDeleteByAuthor(ctx context.Context, authorID int64, questionID string) error
+	ListManaged(ctx context.Context, authorID int64, page, pageSize int) (ListQuestionsResult, error)
+	ListAuthors(ctx context.Context, page, pageSize int) (schema.ListAuthorsResult, error)
+	UpdateManaged(ctx context.Context, editorID int64, questionID string, draft schema.QuestionDraft) (schema.Question, error)
+	SoftDeleteManaged(ctx context.Context, actorID int64, questionID string) (schema.Question, error)
+	ReassignAuthor(ctx context.Context, actorID int64, questionID string, authorID int64) (schema.Question, error)
+	ListDeletedBy(ctx context.Context, userID int64, page, pageSize int) (ListDeletedResult, error)
+	GetDeletedBy(ctx context.Context, userID int64, questionID string) (schema.DeletedQuestion, error)
+	RestoreDeletedBy(ctx context.Context, userID int64, questionID string) error
+	PurgeDeletedBy(ctx context.Context, userID int64, questionID string) error
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error)
 }
@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+)
+
+type RatingRepository interface {
+	Rate(ctx context.Context, rating schema.QuestionRating) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+)
+
+type ReportRepository interface {
+	Create(ctx context.Context, report schema.QuestionReport) error
+	CountOpen(ctx context.Context, questionID string) (int, error)
+	ListOpen(ctx context.Context, page, pageSize int) (schema.ListReportedResult, error)
+	GetOpen(ctx context.Context, questionID string) (schema.ReportedQuestion, error)
+	ResolveOpen(ctx context.Context, questionID string, resolvedBy int64, resolution schema.ReportResolution) error
+}
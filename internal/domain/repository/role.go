@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+)
+
+type RoleRepository interface {
+	List(ctx context.Context) ([]schema.UserRole, error)
+	Set(ctx context.Context, role schema.UserRole) error
+	Delete(ctx context.Context, userID int64) error
+	EnsureOwners(ctx context.Context, userIDs []int64) error
+	ImportRoles(ctx context.Context, source string, userIDs []int64, role schema.Role) (int64, error)
+}
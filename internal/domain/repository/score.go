@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+)
+
+type ScoreRepository interface {
+	RecordTeamScore(ctx context.Context, score schema.QuestionScore) error
+	IsScoredByTeam(ctx context.Context, teamID string, questionID string) (bool, error)
+	CountTeamScore(ctx context.Context, teamID string) (int, error)
+	TeamMemberScores(ctx context.Context, teamID string) (map[int64]int, error)
+	CountUserScore(ctx context.Context, userID int64) (int, error)
+}
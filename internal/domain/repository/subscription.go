@@ -0,0 +1,10 @@
+package repository
+
+import "context"
+
+type SubscriptionRepository interface {
+	Subscribe(ctx context.Context, userID int64) error
+	Unsubscribe(ctx context.Context, userID int64) error
+	IsSubscribed(ctx context.Context, userID int64) (bool, error)
+	ListSubscribers(ctx context.Context) ([]int64, error)
+}
@@ -9,4 +9,7 @@ type UserRepository interface {
 	RegisterStart(ctx context.Context, user schema.BotUser) (schema.BotUser, bool, error)
 	GetByID(ctx context.Context, userID int64) (schema.BotUser, bool, error)
 	TouchInteraction(ctx context.Context, userID int64) error
+	SetDailyQuestion(ctx context.Context, userID int64, enabled bool) error
+	SetLanguage(ctx context.Context, userID int64, lang schema.Language) error
+	ListDailyQuestionUsers(ctx context.Context) ([]schema.BotUser, error)
 }
@@ -0,0 +1,37 @@
+package schema
+
+import "time"
+
+type AuditAction string
+
+const (
+	AuditActionEdit     AuditAction = "edit"
+	AuditActionDelete   AuditAction = "delete"
+	AuditActionReassign AuditAction = "reassign"
+)
+
+type AuditEntry struct {
+	ID           int64
+	QuestionID   string
+	QuestionText string
+	ActorID      int64
+	Action       AuditAction
+	FromAuthorID int64
+	ToAuthorID   int64
+	CreatedAt    time.Time
+}
+
+type ListAuditResult struct {
+	Items []AuditEntry
+	Total int
+}
+
+type AuthorStat struct {
+	AuthorID  int64
+	Questions int
+}
+
+type ListAuthorsResult struct {
+	Items []AuthorStat
+	Total int
+}
@@ -1,6 +1,52 @@
 package schema
 
-import "time"
+import (
+	"strings"
+	"time"
+)
+
+type Language string
+
+const (
+	LanguageAuto    Language = ""
+	LanguageRussian Language = "ru"
+	LanguageEnglish Language = "en"
+
+	DefaultLanguage = LanguageRussian
+)
+
+var Languages = []Language{LanguageRussian, LanguageEnglish}
+
+func (l Language) Valid() bool {
+	if l == LanguageAuto {
+		return true
+	}
+	for _, v := range Languages {
+		if v == l {
+			return true
+		}
+	}
+	return false
+}
+
+var russianLanguageFamily = map[string]bool{"ru": true, "uk": true, "be": true, "kk": true}
+
+func ResolveLanguage(preferred Language, telegramCode string) Language {
+	if preferred != LanguageAuto && preferred.Valid() {
+		return preferred
+	}
+	code := strings.ToLower(strings.TrimSpace(telegramCode))
+	if i := strings.IndexAny(code, "-_"); i >= 0 {
+		code = code[:i]
+	}
+	if code == "" {
+		return DefaultLanguage
+	}
+	if russianLanguageFamily[code] {
+		return LanguageRussian
+	}
+	return LanguageEnglish
+}
 
 type BotUser struct {
 	UserID            int64
@@ -8,7 +54,13 @@ type BotUser struct {
 	LastName          string
 	Username          string
 	LanguageCode      string
+	Language          Language
 	IsBot             bool
+	DailyQuestion     bool
 	RegisteredAt      time.Time
 	LastInteractionAt time.Time
 }
+
+func (u BotUser) PreferredLanguage() Language {
+	return ResolveLanguage(u.Language, u.LanguageCode)
+}
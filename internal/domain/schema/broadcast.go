@@ -0,0 +1,62 @@
+package schema
+
+import "time"
+
+type BroadcastAudience string
+
+const (
+	BroadcastAudienceAll        BroadcastAudience = "all"
+	BroadcastAudienceActive     BroadcastAudience = "active"
+	BroadcastAudienceTeamOwners BroadcastAudience = "owners"
+)
+
+func (a BroadcastAudience) Valid() bool {
+	switch a {
+	case BroadcastAudienceAll, BroadcastAudienceActive, BroadcastAudienceTeamOwners:
+		return true
+	default:
+		return false
+	}
+}
+
+type BroadcastStatus string
+
+const (
+	BroadcastStatusSending BroadcastStatus = "sending"
+	BroadcastStatusDone    BroadcastStatus = "done"
+)
+
+type RecipientStatus string
+
+const (
+	RecipientStatusPending RecipientStatus = "pending"
+	RecipientStatusSent    RecipientStatus = "sent"
+	RecipientStatusBlocked RecipientStatus = "blocked"
+	RecipientStatusFailed  RecipientStatus = "failed"
+)
+
+type BroadcastDraft struct {
+	Text       string            `json:"text"`
+	Audience   BroadcastAudience `json:"audience,omitempty"`
+	ActiveDays int               `json:"active_days,omitempty"`
+}
+
+type BroadcastStats struct {
+	Total   int
+	Pending int
+	Sent    int
+	Blocked int
+	Failed  int
+}
+
+type Broadcast struct {
+	ID         string
+	AuthorID   int64
+	Text       string
+	Audience   BroadcastAudience
+	ActiveDays int
+	Status     BroadcastStatus
+	Stats      BroadcastStats
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+}
@@ -0,0 +1,15 @@
+package schema
+
+const DayLayout = "2006-01-02"
+
+type DailyQuestion struct {
+	Day      string
+	Language Language
+	Question Question
+}
+
+type DailySummary struct {
+	DailyQuestion
+	Answered int
+	Knew     int
+}
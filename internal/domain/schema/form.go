@@ -7,40 +7,73 @@ type FormStep string
 type FormField string
 
 const (
-	FormModeCreate FormMode = "create"
-	FormModeEdit   FormMode = "edit"
+	FormModeCreate    FormMode = "create"
+	FormModeEdit      FormMode = "edit"
+	FormModeSuggest   FormMode = "suggest"
+	FormModeModerate  FormMode = "moderate"
+	FormModeReview    FormMode = "review"
+	FormModeBroadcast FormMode = "broadcast"
+	FormModePack      FormMode = "pack"
+	FormModeSearch    FormMode = "search"
+	FormModeManage    FormMode = "manage"
 )
 
 const (
-	FormStepQuestion    FormStep = "question"
-	FormStepAnswer      FormStep = "answer"
-	FormStepPreview     FormStep = "preview"
-	FormStepChooseField FormStep = "choose_field"
-	FormStepEditInput   FormStep = "edit_input"
-	FormStepPoolInput   FormStep = "pool_input"
-	FormStepPoolPreview FormStep = "pool_preview"
-	FormStepPoolEditQ   FormStep = "pool_edit_q"
-	FormStepPoolEditA   FormStep = "pool_edit_a"
+	FormStepQuestion         FormStep = "question"
+	FormStepAnswer           FormStep = "answer"
+	FormStepCategory         FormStep = "category"
+	FormStepDifficulty       FormStep = "difficulty"
+	FormStepPreview          FormStep = "preview"
+	FormStepChooseField      FormStep = "choose_field"
+	FormStepEditInput        FormStep = "edit_input"
+	FormStepPoolInput        FormStep = "pool_input"
+	FormStepPoolPreview      FormStep = "pool_preview"
+	FormStepPoolEditQ        FormStep = "pool_edit_q"
+	FormStepPoolEditA        FormStep = "pool_edit_a"
+	FormStepRejectReason     FormStep = "reject_reason"
+	FormStepBroadcastText    FormStep = "broadcast_text"
+	FormStepBroadcastDays    FormStep = "broadcast_days"
+	FormStepBroadcastPreview FormStep = "broadcast_preview"
+	FormStepLanguage         FormStep = "language"
+	FormStepImportChoice     FormStep = "import_choice"
+	FormStepPackTitle        FormStep = "pack_title"
+	FormStepPackDescription  FormStep = "pack_description"
+	FormStepSearchQuery      FormStep = "search_query"
+	FormStepSearchResults    FormStep = "search_results"
+	FormStepReassignAuthor   FormStep = "reassign_author"
 )
 
 const (
-	FormFieldQuestion FormField = "question"
-	FormFieldAnswer   FormField = "answer"
+	FormFieldQuestion   FormField = "question"
+	FormFieldAnswer     FormField = "answer"
+	FormFieldCategory   FormField = "category"
+	FormFieldDifficulty FormField = "difficulty"
+	FormFieldLanguage   FormField = "language"
 )
 
 type QuestionDraft struct {
-	QuestionText string `json:"question_text"`
-	AnswerText   string `json:"answer_text"`
+	QuestionText  string             `json:"question_text"`
+	AnswerText    string             `json:"answer_text"`
+	Category      QuestionCategory   `json:"category,omitempty"`
+	Difficulty    QuestionDifficulty `json:"difficulty,omitempty"`
+	Language      Language           `json:"language,omitempty"`
+	TranslationOf string             `json:"translation_of,omitempty"`
+	PackID        string             `json:"pack_id,omitempty"`
 }
 
 type FormState struct {
-	Mode       FormMode      `json:"mode"`
-	Step       FormStep      `json:"step"`
-	QuestionID string        `json:"question_id"`
-	Page       int           `json:"page"`
-	Field      FormField     `json:"field"`
-	Draft      QuestionDraft `json:"draft"`
+	Mode       FormMode        `json:"mode"`
+	Step       FormStep        `json:"step"`
+	QuestionID string          `json:"question_id"`
+	Page       int             `json:"page"`
+	Field      FormField       `json:"field"`
+	Draft      QuestionDraft   `json:"draft"`
 	PoolItems  []QuestionDraft `json:"pool_items,omitempty"`
 	PoolIndex  int             `json:"pool_index,omitempty"`
 	PoolSaved  int             `json:"pool_saved,omitempty"`
+	PoolDupes  []int           `json:"pool_dupes,omitempty"`
+	Broadcast  BroadcastDraft  `json:"broadcast"`
+	Pack       PackDraft       `json:"pack"`
+	Search     string          `json:"search,omitempty"`
+	AuthorID   int64           `json:"author_id,omitempty"`
 }
@@ -0,0 +1,59 @@
+package schema
+
+import "time"
+
+type GameSessionStatus string
+
+const (
+	GameSessionStatusActive   GameSessionStatus = "active"
+	GameSessionStatusFinished GameSessionStatus = "finished"
+)
+
+type GameSession struct {
+	ID         string
+	ChatID     int64
+	StartedBy  int64
+	TeamID     string
+	RoundLimit int
+	Duration   time.Duration
+	Status     GameSessionStatus
+	StartedAt  time.Time
+	EndedAt    time.Time
+	Rounds     []GameRound
+}
+
+type GameRound struct {
+	QuestionID   string
+	QuestionText string
+	DrawnAt      time.Time
+	Revealed     bool
+	ScorerID     int64
+}
+
+func (s GameSession) LastActivityAt() time.Time {
+	last := s.StartedAt
+	for _, r := range s.Rounds {
+		if r.DrawnAt.After(last) {
+			last = r.DrawnAt
+		}
+	}
+	return last
+}
+
+func (s GameSession) LimitReached(now time.Time) bool {
+	if s.RoundLimit > 0 && len(s.Rounds) >= s.RoundLimit {
+		return true
+	}
+	if s.Duration > 0 && now.Sub(s.StartedAt) >= s.Duration {
+		return true
+	}
+	return false
+}
+
+type GameSummary struct {
+	Session  GameSession
+	Played   int
+	Revealed int
+	Scores   map[int64]int
+	Elapsed  time.Duration
+}
@@ -0,0 +1,19 @@
+package schema
+
+type LocalizedText struct {
+	Key  string
+	Args []any
+}
+
+type OutgoingButton struct {
+	Text         string
+	Label        LocalizedText
+	CallbackData string
+}
+
+type OutgoingMessage struct {
+	ChatID  int64
+	Text    string
+	Parts   []LocalizedText
+	Buttons [][]OutgoingButton
+}
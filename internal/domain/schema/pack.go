@@ -0,0 +1,17 @@
+package schema
+
+import "time"
+
+type Pack struct {
+	ID          string
+	Title       string
+	Description string
+	CreatedBy   int64
+	Questions   int
+	CreatedAt   time.Time
+}
+
+type PackDraft struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
@@ -0,0 +1,55 @@
+package schema
+
+import "time"
+
+type PlayScope struct {
+	UserID int64
+	TeamID string
+	ChatID int64
+}
+
+func (s PlayScope) IsTeam() bool {
+	return s.TeamID != ""
+}
+
+func (s PlayScope) IsChat() bool {
+	return s.ChatID != 0
+}
+
+type PlaySettings struct {
+	Categories       []QuestionCategory
+	Difficulty       QuestionDifficulty
+	Language         Language
+	LanguageFallback bool
+	Packs            []string
+	UpdatedAt        time.Time
+}
+
+func (s PlaySettings) HasCategory(c QuestionCategory) bool {
+	for _, v := range s.Categories {
+		if v == c {
+			return true
+		}
+	}
+	return false
+}
+
+func (s PlaySettings) Filter() QuestionFilter {
+	return QuestionFilter{Categories: s.Categories, Difficulty: s.Difficulty, Packs: s.Packs}
+}
+
+func (s PlaySettings) HasPack(id string) bool {
+	for _, v := range s.Packs {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (s PlaySettings) QuestionLanguage(player Language) Language {
+	if s.Language != LanguageAuto {
+		return s.Language
+	}
+	return player
+}
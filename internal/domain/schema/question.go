@@ -5,17 +5,179 @@ import "time"
 type QuestionStatus string
 
 const (
-	QuestionStatusActive  QuestionStatus = "active"
-	QuestionStatusDeleted QuestionStatus = "deleted"
-	QuestionStatusDraft   QuestionStatus = "draft"
+	QuestionStatusActive   QuestionStatus = "active"
+	QuestionStatusDeleted  QuestionStatus = "deleted"
+	QuestionStatusDraft    QuestionStatus = "draft"
+	QuestionStatusRejected QuestionStatus = "rejected"
+	QuestionStatusHidden   QuestionStatus = "hidden"
 )
 
+func (s QuestionStatus) VisibleToAuthor() bool {
+	return s == QuestionStatusActive || s == QuestionStatusHidden || s == QuestionStatusDraft
+}
+
+type QuestionCategory string
+
+const (
+	CategoryHistory    QuestionCategory = "history"
+	CategoryMovies     QuestionCategory = "movies"
+	CategoryScience    QuestionCategory = "science"
+	CategoryGeography  QuestionCategory = "geography"
+	CategorySport      QuestionCategory = "sport"
+	CategoryMusic      QuestionCategory = "music"
+	CategoryLiterature QuestionCategory = "literature"
+	CategoryOther      QuestionCategory = "other"
+)
+
+var QuestionCategories = []QuestionCategory{
+	CategoryHistory,
+	CategoryMovies,
+	CategoryScience,
+	CategoryGeography,
+	CategorySport,
+	CategoryMusic,
+	CategoryLiterature,
+	CategoryOther,
+}
+
+func (c QuestionCategory) Valid() bool {
+	for _, v := range QuestionCategories {
+		if v == c {
+			return true
+		}
+	}
+	return false
+}
+
+type QuestionDifficulty string
+
+const (
+	DifficultyEasy   QuestionDifficulty = "easy"
+	DifficultyMedium QuestionDifficulty = "medium"
+	DifficultyHard   QuestionDifficulty = "hard"
+	DifficultyMixed  QuestionDifficulty = "mixed"
+)
+
+var QuestionDifficulties = []QuestionDifficulty{
+	DifficultyEasy,
+	DifficultyMedium,
+	DifficultyHard,
+}
+
+func (d QuestionDifficulty) Valid() bool {
+	for _, v := range QuestionDifficulties {
+		if v == d {
+			return true
+		}
+	}
+	return false
+}
+
+func (d QuestionDifficulty) ValidChoice() bool {
+	return d == DifficultyMixed || d.Valid()
+}
+
 type Question struct {
-	ID           string
+	ID               string
+	QuestionText     string
+	AnswerText       string
+	Category         QuestionCategory
+	Difficulty       QuestionDifficulty
+	AuthorID         int64
+	Status           QuestionStatus
+	Likes            int
+	Dislikes         int
+	Language         Language
+	TranslationGroup string
+	PackID           string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type QuestionExport struct {
+	Question
+	Plays   int
+	Guessed int
+	Reports int
+}
+
+type DeletedQuestion struct {
+	Question
+	DeletedAt time.Time
+}
+
+type QuestionRevision struct {
+	ID           int64
+	QuestionID   string
+	Number       int
 	QuestionText string
 	AnswerText   string
-	AuthorID     int64
-	Status       QuestionStatus
+	Category     QuestionCategory
+	Difficulty   QuestionDifficulty
+	Language     Language
+	EditedBy     int64
 	CreatedAt    time.Time
-	UpdatedAt    time.Time
+}
+
+func (r QuestionRevision) Draft() QuestionDraft {
+	return QuestionDraft{
+		QuestionText: r.QuestionText,
+		AnswerText:   r.AnswerText,
+		Category:     r.Category,
+		Difficulty:   r.Difficulty,
+		Language:     r.Language,
+	}
+}
+
+type SimilarQuestion struct {
+	Question
+	Similarity float64
+}
+
+type DuplicatePair struct {
+	Left       Question
+	Right      Question
+	Similarity float64
+}
+
+type DuplicateCluster struct {
+	Questions  []Question
+	Similarity float64
+}
+
+func (q Question) RatingWeight() float64 {
+	return float64(q.Likes+1) / float64(q.Likes+q.Dislikes+2)
+}
+
+type QuestionFilter struct {
+	Categories []QuestionCategory
+	Difficulty QuestionDifficulty
+	Language   Language
+	Packs      []string
+}
+
+func (f QuestionFilter) CategoryKeys() []string {
+	out := make([]string, 0, len(f.Categories))
+	for _, c := range f.Categories {
+		out = append(out, string(c))
+	}
+	return out
+}
+
+func (f QuestionFilter) PackKeys() []string {
+	return append(make([]string, 0, len(f.Packs)), f.Packs...)
+}
+
+func (f QuestionFilter) DifficultyKey() string {
+	if !f.Difficulty.Valid() {
+		return string(DifficultyMixed)
+	}
+	return string(f.Difficulty)
+}
+
+func (f QuestionFilter) LanguageKey() string {
+	if !f.Language.Valid() {
+		return string(LanguageAuto)
+	}
+	return string(f.Language)
 }
@@ -0,0 +1,18 @@
+package schema
+
+type RatingValue int
+
+const (
+	RatingUp   RatingValue = 1
+	RatingDown RatingValue = -1
+)
+
+func (v RatingValue) Valid() bool {
+	return v == RatingUp || v == RatingDown
+}
+
+type QuestionRating struct {
+	QuestionID string
+	UserID     int64
+	Value      RatingValue
+}
@@ -0,0 +1,55 @@
+package schema
+
+import "time"
+
+type ReportReason string
+
+const (
+	ReportReasonWrongAnswer ReportReason = "wrong"
+	ReportReasonOffensive   ReportReason = "offensive"
+	ReportReasonDuplicate   ReportReason = "duplicate"
+	ReportReasonOther       ReportReason = "other"
+)
+
+var ReportReasons = []ReportReason{
+	ReportReasonWrongAnswer,
+	ReportReasonOffensive,
+	ReportReasonDuplicate,
+	ReportReasonOther,
+}
+
+func (r ReportReason) Valid() bool {
+	for _, v := range ReportReasons {
+		if v == r {
+			return true
+		}
+	}
+	return false
+}
+
+type ReportResolution string
+
+const (
+	ReportResolutionEdited      ReportResolution = "edited"
+	ReportResolutionDeactivated ReportResolution = "deactivated"
+	ReportResolutionDismissed   ReportResolution = "dismissed"
+)
+
+type QuestionReport struct {
+	QuestionID string
+	UserID     int64
+	Reason     ReportReason
+	CreatedAt  time.Time
+}
+
+type ReportedQuestion struct {
+	Question     Question
+	Reports      int
+	Reasons      map[ReportReason]int
+	LastReportAt time.Time
+}
+
+type ListReportedResult struct {
+	Items []ReportedQuestion
+	Total int
+}
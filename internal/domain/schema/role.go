@@ -0,0 +1,61 @@
+package schema
+
+import "time"
+
+type Role string
+
+const (
+	RoleOwner     Role = "owner"
+	RoleAdmin     Role = "admin"
+	RoleModerator Role = "moderator"
+	RoleAuthor    Role = "author"
+)
+
+var Roles = []Role{
+	RoleOwner,
+	RoleAdmin,
+	RoleModerator,
+	RoleAuthor,
+}
+
+func (r Role) Valid() bool {
+	for _, v := range Roles {
+		if v == r {
+			return true
+		}
+	}
+	return false
+}
+
+type Permission string
+
+const (
+	PermissionAuthor      Permission = "author"
+	PermissionModerate    Permission = "moderate"
+	PermissionBroadcast   Permission = "broadcast"
+	PermissionEditOthers  Permission = "edit_others"
+	PermissionManageRoles Permission = "manage_roles"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleOwner:     {PermissionAuthor, PermissionModerate, PermissionBroadcast, PermissionEditOthers, PermissionManageRoles},
+	RoleAdmin:     {PermissionAuthor, PermissionModerate, PermissionBroadcast, PermissionEditOthers},
+	RoleModerator: {PermissionAuthor, PermissionModerate},
+	RoleAuthor:    {PermissionAuthor},
+}
+
+func (r Role) Can(p Permission) bool {
+	for _, v := range rolePermissions[r] {
+		if v == p {
+			return true
+		}
+	}
+	return false
+}
+
+type UserRole struct {
+	UserID    int64
+	Role      Role
+	GrantedBy int64
+	GrantedAt time.Time
+}
@@ -0,0 +1,15 @@
+package schema
+
+import "time"
+
+type QuestionScore struct {
+	TeamID     string
+	QuestionID string
+	UserID     int64
+	ScoredBy   int64
+	ScoredAt   time.Time
+}
+
+func (s QuestionScore) Nobody() bool {
+	return s.UserID == 0
+}
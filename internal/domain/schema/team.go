@@ -15,12 +15,14 @@ type TeamMember struct {
 	LastName  string
 	Username  string
 	JoinedAt  time.Time
+	Score     int
 }
 
 type TeamWithMembers struct {
-	Team        Team
-	Members     []TeamMember
-	AnsweredCnt int
+	Team    Team
+	Members []TeamMember
+	SeenCnt int
+	Score   int
 }
 
 type UserProfile struct {
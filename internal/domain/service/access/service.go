@@ -1,17 +1,189 @@
 package access
 
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	refreshInterval = time.Minute
+
+	legacySuperAdminSource = "SUPER_ADMIN_IDS"
+)
+
 type Service struct {
-	admins map[int64]struct{}
+	repo   repository.RoleRepository
+	owners map[int64]struct{}
+
+	mu    sync.RWMutex
+	roles map[int64]schema.UserRole
+}
+
+func New(repo repository.RoleRepository, owners map[int64]struct{}) *Service {
+	if owners == nil {
+		owners = map[int64]struct{}{}
+	}
+	return &Service{repo: repo, owners: owners, roles: map[int64]schema.UserRole{}}
+}
+
+func (s *Service) Load(ctx context.Context) error {
+	ids := make([]int64, 0, len(s.owners))
+	for id := range s.owners {
+		ids = append(ids, id)
+	}
+	if err := s.repo.EnsureOwners(ctx, ids); err != nil {
+		return err
+	}
+	if len(ids) > 0 {
+		log.Printf("access: %d user(s) from ADMIN_IDS have the owner role with role management", len(ids))
+	}
+	return s.reload(ctx)
+}
+
+func (s *Service) ImportLegacySuperAdmins(ctx context.Context, ids map[int64]struct{}) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	list := make([]int64, 0, len(ids))
+	for id := range ids {
+		list = append(list, id)
+	}
+	n, err := s.repo.ImportRoles(ctx, legacySuperAdminSource, list, schema.RoleAdmin)
+	if errors.Is(err, errorz.ErrAlreadyExists) {
+		log.Printf("WARNING: %s is deprecated and was already imported; remove it and manage roles with /grant and /revoke", legacySuperAdminSource)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log.Printf("WARNING: %s is deprecated; granted the admin role to %d of %d user(s), users with an existing role were kept; remove the variable and manage roles with /grant and /revoke", legacySuperAdminSource, n, len(list))
+	return s.reload(ctx)
+}
+
+func (s *Service) Start(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(refreshInterval):
+		}
+		if err := s.reload(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("reload roles: %v", err)
+		}
+	}
+}
+
+func (s *Service) reload(ctx context.Context) error {
+	list, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+	roles := make(map[int64]schema.UserRole, len(list)+len(s.owners))
+	for _, r := range list {
+		roles[r.UserID] = r
+	}
+	for id := range s.owners {
+		if _, ok := roles[id]; !ok {
+			roles[id] = schema.UserRole{UserID: id, Role: schema.RoleOwner}
+		}
+	}
+	s.mu.Lock()
+	s.roles = roles
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *Service) Role(userID int64) (schema.Role, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	r, ok := s.roles[userID]
+	return r.Role, ok
 }
 
-func New(admins map[int64]struct{}) *Service {
-	if admins == nil {
-		admins = map[int64]struct{}{}
+func (s *Service) IsStaff(userID int64) bool {
+	_, ok := s.Role(userID)
+	return ok
+}
+
+func (s *Service) Can(userID int64, p schema.Permission) bool {
+	role, ok := s.Role(userID)
+	return ok && role.Can(p)
+}
+
+func (s *Service) UserIDsWith(p schema.Permission) []int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]int64, 0, len(s.roles))
+	for id, r := range s.roles {
+		if r.Role.Can(p) {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+func (s *Service) Members() []schema.UserRole {
+	s.mu.RLock()
+	out := make([]schema.UserRole, 0, len(s.roles))
+	for _, r := range s.roles {
+		out = append(out, r)
 	}
-	return &Service{admins: admins}
+	s.mu.RUnlock()
+	sort.Slice(out, func(i, j int) bool {
+		ri, rj := slices.Index(schema.Roles, out[i].Role), slices.Index(schema.Roles, out[j].Role)
+		if ri != rj {
+			return ri < rj
+		}
+		return out[i].UserID < out[j].UserID
+	})
+	return out
 }
 
-func (s *Service) IsAdmin(userID int64) bool {
-	_, ok := s.admins[userID]
+func (s *Service) IsBootstrapOwner(userID int64) bool {
+	_, ok := s.owners[userID]
 	return ok
 }
+
+func (s *Service) Grant(ctx context.Context, actorID, userID int64, role schema.Role) error {
+	if err := s.checkChange(actorID, userID); err != nil {
+		return err
+	}
+	if !role.Valid() {
+		return errorz.ErrInvalid
+	}
+	if err := s.repo.Set(ctx, schema.UserRole{UserID: userID, Role: role, GrantedBy: actorID}); err != nil {
+		return err
+	}
+	return s.reload(ctx)
+}
+
+func (s *Service) Revoke(ctx context.Context, actorID, userID int64) error {
+	if err := s.checkChange(actorID, userID); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, userID); err != nil {
+		return err
+	}
+	return s.reload(ctx)
+}
+
+func (s *Service) checkChange(actorID, userID int64) error {
+	if !s.Can(actorID, schema.PermissionManageRoles) {
+		return errorz.ErrForbidden
+	}
+	if userID <= 0 {
+		return errorz.ErrInvalid
+	}
+	if userID == actorID || s.IsBootstrapOwner(userID) {
+		return errorz.ErrConflict
+	}
+	return nil
+}
@@ -5,62 +5,510 @@ import (
 	"LoudQuestionBot/internal/domain/repository"
 	"LoudQuestionBot/internal/domain/schema"
 	"context"
+	"fmt"
+	"sort"
 	"strings"
+	"unicode"
 	"unicode/utf8"
 )
 
+const (
+	maxReasonLen        = 500
+	duplicateThreshold  = 0.6
+	duplicateMatches    = 3
+	duplicatePairsLimit = 1000
+	MaxSearchLen        = 100
+	MaxPendingPerAuthor = 5
+)
+
+var ErrTooManyPending = fmt.Errorf("%w: too many pending suggestions", errorz.ErrLimitExceeded)
+
+type DuplicateError struct {
+	Matches []schema.SimilarQuestion
+}
+
+func (e *DuplicateError) Error() string {
+	return fmt.Sprintf("%d similar questions already exist", len(e.Matches))
+}
+
+func (e *DuplicateError) Unwrap() error {
+	return errorz.ErrDuplicate
+}
+
+type QuestionsListener interface {
+	QuestionsAdded()
+}
+
 type Service struct {
 	questions repository.QuestionRepository
+	reports   repository.ReportRepository
+	audit     repository.AuditRepository
+	listener  QuestionsListener
 }
 
-func New(questions repository.QuestionRepository) *Service {
-	return &Service{questions: questions}
+func New(questions repository.QuestionRepository, reports repository.ReportRepository, audit repository.AuditRepository, listener QuestionsListener) *Service {
+	return &Service{questions: questions, reports: reports, audit: audit, listener: listener}
 }
 
-func (s *Service) CreateQuestion(ctx context.Context, authorID int64, draft schema.QuestionDraft) (schema.Question, error) {
-	if err := validateDraft(draft); err != nil {
+func (s *Service) CreateQuestion(ctx context.Context, authorID int64, draft schema.QuestionDraft, allowDuplicate bool) (schema.Question, error) {
+	draft, err := normalizeDraft(draft)
+	if err != nil {
+		return schema.Question{}, err
+	}
+	if !allowDuplicate {
+		matches, err := s.questions.FindSimilar(ctx, draft.QuestionText, draft.Language, duplicateThreshold, duplicateMatches)
+		if err != nil {
+			return schema.Question{}, err
+		}
+		if len(matches) > 0 {
+			return schema.Question{}, &DuplicateError{Matches: matches}
+		}
+	}
+	src, err := s.translationSource(ctx, draft)
+	if err != nil {
 		return schema.Question{}, err
 	}
+	if src.ID != "" {
+		draft.PackID = src.PackID
+	}
 	created, err := s.questions.Create(ctx, schema.Question{
+		QuestionText:     draft.QuestionText,
+		AnswerText:       draft.AnswerText,
+		Category:         draft.Category,
+		Difficulty:       draft.Difficulty,
+		AuthorID:         authorID,
+		Status:           schema.QuestionStatusActive,
+		Language:         draft.Language,
+		TranslationGroup: src.TranslationGroup,
+		PackID:           draft.PackID,
+	})
+	if err != nil {
+		return schema.Question{}, err
+	}
+	if err := s.questions.MarkSeenByUser(ctx, authorID, created.ID); err != nil {
+		return schema.Question{}, err
+	}
+	s.listener.QuestionsAdded()
+	return created, nil
+}
+
+func (s *Service) ImportQuestions(ctx context.Context, authorID int64, drafts []schema.QuestionDraft) (int, error) {
+	questions := make([]schema.Question, 0, len(drafts))
+	for _, d := range drafts {
+		draft, err := normalizeDraft(d)
+		if err != nil {
+			return 0, err
+		}
+		if draft.QuestionText == "" || draft.AnswerText == "" {
+			return 0, errorz.ErrInvalid
+		}
+		questions = append(questions, schema.Question{
+			QuestionText: draft.QuestionText,
+			AnswerText:   draft.AnswerText,
+			Category:     draft.Category,
+			Difficulty:   draft.Difficulty,
+			Language:     draft.Language,
+			PackID:       draft.PackID,
+		})
+	}
+	if len(questions) == 0 {
+		return 0, nil
+	}
+	created, err := s.questions.CreateBatch(ctx, authorID, questions)
+	if err != nil {
+		return 0, err
+	}
+	s.listener.QuestionsAdded()
+	return created, nil
+}
+
+func (s *Service) SimilarQuestions(ctx context.Context, draft schema.QuestionDraft) ([]schema.SimilarQuestion, error) {
+	draft, err := normalizeDraft(draft)
+	if err != nil {
+		return nil, err
+	}
+	return s.questions.FindSimilar(ctx, draft.QuestionText, draft.Language, duplicateThreshold, duplicateMatches)
+}
+
+func (s *Service) FindDuplicates(ctx context.Context, drafts []schema.QuestionDraft) (map[int]schema.SimilarQuestion, error) {
+	normalized := make([]schema.QuestionDraft, 0, len(drafts))
+	for _, d := range drafts {
+		draft, err := normalizeDraft(d)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, draft)
+	}
+	out, err := s.questions.FindSimilarBatch(ctx, normalized, duplicateThreshold)
+	if err != nil {
+		return nil, err
+	}
+	first := make(map[string]int, len(normalized))
+	for i, d := range normalized {
+		key := string(d.Language) + ":" + duplicateKey(d.QuestionText)
+		j, ok := first[key]
+		if !ok {
+			first[key] = i
+			continue
+		}
+		if _, found := out[i]; !found {
+			out[i] = schema.SimilarQuestion{
+				Question:   schema.Question{QuestionText: normalized[j].QuestionText, Language: normalized[j].Language},
+				Similarity: 1,
+			}
+		}
+	}
+	return out, nil
+}
+
+func (s *Service) DuplicateClusters(ctx context.Context) ([]schema.DuplicateCluster, error) {
+	pairs, err := s.questions.ListSimilarPairs(ctx, duplicateThreshold, duplicatePairsLimit)
+	if err != nil {
+		return nil, err
+	}
+	parent := make(map[string]string)
+	var find func(id string) string
+	find = func(id string) string {
+		p, ok := parent[id]
+		if !ok || p == id {
+			return id
+		}
+		root := find(p)
+		parent[id] = root
+		return root
+	}
+	questions := make(map[string]schema.Question)
+	for _, p := range pairs {
+		questions[p.Left.ID] = p.Left
+		questions[p.Right.ID] = p.Right
+		if l, r := find(p.Left.ID), find(p.Right.ID); l != r {
+			parent[r] = l
+		}
+	}
+
+	byRoot := make(map[string]*schema.DuplicateCluster)
+	for _, p := range pairs {
+		root := find(p.Left.ID)
+		cluster, ok := byRoot[root]
+		if !ok {
+			cluster = &schema.DuplicateCluster{}
+			byRoot[root] = cluster
+		}
+		cluster.Similarity = max(cluster.Similarity, p.Similarity)
+	}
+	for id, q := range questions {
+		cluster := byRoot[find(id)]
+		cluster.Questions = append(cluster.Questions, q)
+	}
+
+	out := make([]schema.DuplicateCluster, 0, len(byRoot))
+	for _, cluster := range byRoot {
+		sort.Slice(cluster.Questions, func(i, j int) bool {
+			return cluster.Questions[i].CreatedAt.Before(cluster.Questions[j].CreatedAt)
+		})
+		out = append(out, *cluster)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Similarity != out[j].Similarity {
+			return out[i].Similarity > out[j].Similarity
+		}
+		if len(out[i].Questions) != len(out[j].Questions) {
+			return len(out[i].Questions) > len(out[j].Questions)
+		}
+		return out[i].Questions[0].CreatedAt.Before(out[j].Questions[0].CreatedAt)
+	})
+	return out, nil
+}
+
+func (s *Service) SubmitQuestion(ctx context.Context, authorID int64, draft schema.QuestionDraft) (schema.Question, error) {
+	draft, err := normalizeDraft(draft)
+	if err != nil {
+		return schema.Question{}, err
+	}
+	pending, err := s.questions.CountByAuthorStatus(ctx, authorID, schema.QuestionStatusDraft)
+	if err != nil {
+		return schema.Question{}, err
+	}
+	if pending >= MaxPendingPerAuthor {
+		return schema.Question{}, ErrTooManyPending
+	}
+	return s.questions.Create(ctx, schema.Question{
 		QuestionText: draft.QuestionText,
 		AnswerText:   draft.AnswerText,
+		Category:     draft.Category,
+		Difficulty:   draft.Difficulty,
 		AuthorID:     authorID,
-		Status:       schema.QuestionStatusActive,
+		Status:       schema.QuestionStatusDraft,
+		Language:     draft.Language,
 	})
+}
+
+func (s *Service) PendingQuestions(ctx context.Context, page, pageSize int) (repository.ListQuestionsResult, error) {
+	return s.questions.ListByStatus(ctx, schema.QuestionStatusDraft, page, pageSize)
+}
+
+func (s *Service) PendingCount(ctx context.Context) (int, error) {
+	return s.questions.CountByStatus(ctx, schema.QuestionStatusDraft)
+}
+
+func (s *Service) UpdatePendingQuestion(ctx context.Context, moderatorID int64, questionID string, draft schema.QuestionDraft) (schema.Question, error) {
+	draft, err := normalizeDraft(draft)
 	if err != nil {
 		return schema.Question{}, err
 	}
-	if err := s.questions.MarkSeenByUser(ctx, authorID, created.ID); err != nil {
+	return s.questions.UpdatePending(ctx, moderatorID, questionID, draft)
+}
+
+func (s *Service) ApproveQuestion(ctx context.Context, moderatorID int64, questionID string) (schema.Question, error) {
+	q, err := s.questions.Moderate(ctx, questionID, schema.QuestionStatusActive, moderatorID, "")
+	if err != nil {
 		return schema.Question{}, err
 	}
-	return created, nil
+	if err := s.questions.MarkSeenByUser(ctx, q.AuthorID, q.ID); err != nil {
+		return schema.Question{}, err
+	}
+	s.listener.QuestionsAdded()
+	return q, nil
+}
+
+func (s *Service) RejectQuestion(ctx context.Context, moderatorID int64, questionID string, reason string) (schema.Question, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return schema.Question{}, errorz.ErrInvalid
+	}
+	if utf8.RuneCountInString(reason) > maxReasonLen {
+		return schema.Question{}, errorz.ErrLimitExceeded
+	}
+	return s.questions.Moderate(ctx, questionID, schema.QuestionStatusRejected, moderatorID, reason)
+}
+
+func (s *Service) ReportedQuestions(ctx context.Context, page, pageSize int) (schema.ListReportedResult, error) {
+	return s.reports.ListOpen(ctx, page, pageSize)
+}
+
+func (s *Service) ReportedCount(ctx context.Context) (int, error) {
+	res, err := s.reports.ListOpen(ctx, 1, 1)
+	if err != nil {
+		return 0, err
+	}
+	return res.Total, nil
+}
+
+func (s *Service) ReportedQuestion(ctx context.Context, questionID string) (schema.ReportedQuestion, error) {
+	return s.reports.GetOpen(ctx, questionID)
+}
+
+func (s *Service) EditReportedQuestion(ctx context.Context, moderatorID int64, questionID string, draft schema.QuestionDraft) (schema.Question, error) {
+	draft, err := normalizeDraft(draft)
+	if err != nil {
+		return schema.Question{}, err
+	}
+	q, err := s.questions.UpdateReviewed(ctx, moderatorID, questionID, draft)
+	if err != nil {
+		return schema.Question{}, err
+	}
+	if err := s.reports.ResolveOpen(ctx, questionID, moderatorID, schema.ReportResolutionEdited); err != nil {
+		return schema.Question{}, err
+	}
+	return q, nil
+}
+
+func (s *Service) DeactivateReportedQuestion(ctx context.Context, moderatorID int64, questionID string) error {
+	if err := s.questions.SetReviewedStatus(ctx, questionID, schema.QuestionStatusDeleted); err != nil {
+		return err
+	}
+	return s.reports.ResolveOpen(ctx, questionID, moderatorID, schema.ReportResolutionDeactivated)
+}
+
+func (s *Service) DismissReports(ctx context.Context, moderatorID int64, questionID string) error {
+	if err := s.questions.SetReviewedStatus(ctx, questionID, schema.QuestionStatusActive); err != nil {
+		return err
+	}
+	if err := s.reports.ResolveOpen(ctx, questionID, moderatorID, schema.ReportResolutionDismissed); err != nil {
+		return err
+	}
+	s.listener.QuestionsAdded()
+	return nil
+}
+
+func (s *Service) ExportQuestions(ctx context.Context, authorID int64) ([]schema.QuestionExport, error) {
+	return s.questions.ExportByAuthor(ctx, authorID)
 }
 
 func (s *Service) MyQuestions(ctx context.Context, authorID int64, page, pageSize int) (repository.ListQuestionsResult, error) {
 	return s.questions.ListByAuthor(ctx, authorID, page, pageSize)
 }
 
+func (s *Service) SearchMyQuestions(ctx context.Context, authorID int64, query string, page, pageSize int) (repository.ListQuestionsResult, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return repository.ListQuestionsResult{}, errorz.ErrInvalid
+	}
+	if utf8.RuneCountInString(query) > MaxSearchLen {
+		return repository.ListQuestionsResult{}, errorz.ErrLimitExceeded
+	}
+	return s.questions.SearchByAuthor(ctx, authorID, query, page, pageSize)
+}
+
 func (s *Service) GetQuestion(ctx context.Context, questionID string) (schema.Question, error) {
 	return s.questions.GetByID(ctx, questionID)
 }
 
+func (s *Service) Translations(ctx context.Context, questionID string) ([]schema.Question, error) {
+	return s.questions.ListTranslations(ctx, questionID)
+}
+
+func (s *Service) translationSource(ctx context.Context, draft schema.QuestionDraft) (schema.Question, error) {
+	if draft.TranslationOf == "" {
+		return schema.Question{}, nil
+	}
+	src, err := s.questions.GetByID(ctx, draft.TranslationOf)
+	if err != nil {
+		return schema.Question{}, err
+	}
+	if src.Status != schema.QuestionStatusActive {
+		return schema.Question{}, errorz.ErrNotFound
+	}
+	if src.Language == draft.Language {
+		return schema.Question{}, errorz.ErrAlreadyExists
+	}
+	translations, err := s.questions.ListTranslations(ctx, src.ID)
+	if err != nil {
+		return schema.Question{}, err
+	}
+	for _, t := range translations {
+		if t.Language == draft.Language {
+			return schema.Question{}, errorz.ErrAlreadyExists
+		}
+	}
+	return src, nil
+}
+
 func (s *Service) UpdateQuestion(ctx context.Context, authorID int64, questionID string, draft schema.QuestionDraft) (schema.Question, error) {
-	if err := validateDraft(draft); err != nil {
+	draft, err := normalizeDraft(draft)
+	if err != nil {
 		return schema.Question{}, err
 	}
 	return s.questions.UpdateByAuthor(ctx, authorID, questionID, draft)
 }
 
+func (s *Service) Revisions(ctx context.Context, authorID int64, questionID string) ([]schema.QuestionRevision, error) {
+	q, err := s.questions.GetByID(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+	if q.AuthorID != authorID || !q.Status.VisibleToAuthor() {
+		return nil, errorz.ErrForbidden
+	}
+	revisions, err := s.questions.ListRevisions(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 {
+		revisions = []schema.QuestionRevision{{
+			QuestionID:   q.ID,
+			Number:       1,
+			QuestionText: q.QuestionText,
+			AnswerText:   q.AnswerText,
+			Category:     q.Category,
+			Difficulty:   q.Difficulty,
+			Language:     q.Language,
+			EditedBy:     q.AuthorID,
+			CreatedAt:    q.UpdatedAt,
+		}}
+	}
+	return revisions, nil
+}
+
+func (s *Service) RestoreRevision(ctx context.Context, authorID int64, questionID string, revisionID int64) (schema.QuestionRevision, error) {
+	revisions, err := s.Revisions(ctx, authorID, questionID)
+	if err != nil {
+		return schema.QuestionRevision{}, err
+	}
+	for _, rev := range revisions {
+		if rev.ID != revisionID || rev.ID == 0 {
+			continue
+		}
+		if _, err := s.UpdateQuestion(ctx, authorID, questionID, rev.Draft()); err != nil {
+			return schema.QuestionRevision{}, err
+		}
+		return rev, nil
+	}
+	return schema.QuestionRevision{}, errorz.ErrNotFound
+}
+
 func (s *Service) DeleteQuestion(ctx context.Context, authorID int64, questionID string) error {
 	return s.questions.SoftDeleteByAuthor(ctx, authorID, questionID)
 }
 
-func validateDraft(draft schema.QuestionDraft) error {
+func (s *Service) AllQuestions(ctx context.Context, authorID int64, page, pageSize int) (repository.ListQuestionsResult, error) {
+	return s.questions.ListManaged(ctx, authorID, page, pageSize)
+}
+
+func (s *Service) QuestionAuthors(ctx context.Context, page, pageSize int) (schema.ListAuthorsResult, error) {
+	return s.questions.ListAuthors(ctx, page, pageSize)
+}
+
+func (s *Service) EditAnyQuestion(ctx context.Context, actorID int64, questionID string, draft schema.QuestionDraft) (schema.Question, error) {
+	draft, err := normalizeDraft(draft)
+	if err != nil {
+		return schema.Question{}, err
+	}
+	return s.questions.UpdateManaged(ctx, actorID, questionID, draft)
+}
+
+func (s *Service) DeleteAnyQuestion(ctx context.Context, actorID int64, questionID string) error {
+	_, err := s.questions.SoftDeleteManaged(ctx, actorID, questionID)
+	return err
+}
+
+func (s *Service) ReassignQuestion(ctx context.Context, actorID int64, questionID string, authorID int64) (schema.Question, error) {
+	if authorID <= 0 {
+		return schema.Question{}, errorz.ErrInvalid
+	}
+	return s.questions.ReassignAuthor(ctx, actorID, questionID, authorID)
+}
+
+func (s *Service) AuditLog(ctx context.Context, questionID string, page, pageSize int) (schema.ListAuditResult, error) {
+	return s.audit.List(ctx, questionID, page, pageSize)
+}
+
+func duplicateKey(text string) string {
+	text = strings.ReplaceAll(strings.ToLower(text), "ё", "е")
+	return strings.Join(strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+func normalizeDraft(draft schema.QuestionDraft) (schema.QuestionDraft, error) {
 	const maxLen = 250
 	q := strings.TrimSpace(draft.QuestionText)
 	a := strings.TrimSpace(draft.AnswerText)
+	if q == "" || a == "" {
+		return schema.QuestionDraft{}, errorz.ErrInvalid
+	}
 	if utf8.RuneCountInString(q) > maxLen || utf8.RuneCountInString(a) > maxLen {
-		return errorz.ErrLimitExceeded
+		return schema.QuestionDraft{}, errorz.ErrLimitExceeded
 	}
-	return nil
+	draft.QuestionText = q
+	draft.AnswerText = a
+	if draft.Category == "" {
+		draft.Category = schema.CategoryOther
+	}
+	if !draft.Category.Valid() {
+		return schema.QuestionDraft{}, errorz.ErrInvalid
+	}
+	if draft.Difficulty == "" {
+		draft.Difficulty = schema.DifficultyMedium
+	}
+	if !draft.Difficulty.Valid() {
+		return schema.QuestionDraft{}, errorz.ErrInvalid
+	}
+	if draft.Language == schema.LanguageAuto {
+		draft.Language = schema.DefaultLanguage
+	}
+	if !draft.Language.Valid() {
+		return schema.QuestionDraft{}, errorz.ErrInvalid
+	}
+	return draft, nil
 }
@@ -0,0 +1,162 @@
+package broadcast
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"LoudQuestionBot/internal/domain/service/notify"
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	MaxTextLen    = 3500
+	MaxActiveDays = 365
+	batchSize     = 100
+)
+
+type Service struct {
+	repo     repository.BroadcastRepository
+	notifier *notify.Service
+
+	launched chan struct{}
+}
+
+func New(repo repository.BroadcastRepository, notifier *notify.Service) *Service {
+	return &Service{repo: repo, notifier: notifier, launched: make(chan struct{}, 1)}
+}
+
+func (s *Service) AudienceSize(ctx context.Context, draft schema.BroadcastDraft) (int, error) {
+	draft, err := normalizeDraft(draft)
+	if err != nil {
+		return 0, err
+	}
+	return s.repo.CountAudience(ctx, draft)
+}
+
+func (s *Service) Launch(ctx context.Context, authorID int64, draft schema.BroadcastDraft) (schema.Broadcast, error) {
+	draft, err := normalizeDraft(draft)
+	if err != nil {
+		return schema.Broadcast{}, err
+	}
+	b, err := s.repo.Create(ctx, authorID, draft)
+	if err != nil {
+		return schema.Broadcast{}, err
+	}
+	select {
+	case s.launched <- struct{}{}:
+	default:
+	}
+	return b, nil
+}
+
+func (s *Service) Recent(ctx context.Context, limit int) ([]schema.Broadcast, error) {
+	return s.repo.ListRecent(ctx, limit)
+}
+
+func (s *Service) Start(ctx context.Context) {
+	for {
+		for {
+			err := s.sendNext(ctx)
+			if err == nil {
+				continue
+			}
+			if !errors.Is(err, errorz.ErrNotFound) && ctx.Err() == nil {
+				log.Printf("broadcast: %v", err)
+			}
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.launched:
+		}
+	}
+}
+
+type delivery struct {
+	userID int64
+	err    error
+}
+
+func (s *Service) sendNext(ctx context.Context) error {
+	b, err := s.repo.NextSending(ctx)
+	if err != nil {
+		return err
+	}
+	for {
+		userIDs, err := s.repo.PendingRecipients(ctx, b.ID, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(userIDs) == 0 {
+			break
+		}
+		results := make(chan delivery, len(userIDs))
+		for _, userID := range userIDs {
+			if err := s.notifier.EnqueueWithResult(ctx, schema.OutgoingMessage{ChatID: userID, Text: b.Text}, func(err error) {
+				results <- delivery{userID: userID, err: err}
+			}); err != nil {
+				return err
+			}
+		}
+		for range userIDs {
+			var d delivery
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case d = <-results:
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			status, errText := recipientStatus(d.err)
+			if err := s.repo.SetRecipientStatus(ctx, b.ID, d.userID, status, errText); err != nil {
+				return err
+			}
+		}
+	}
+
+	done, err := s.repo.Finish(ctx, b.ID)
+	if err != nil {
+		return err
+	}
+	log.Printf("broadcast %s finished: sent=%d blocked=%d failed=%d", done.ID, done.Stats.Sent, done.Stats.Blocked, done.Stats.Failed)
+	return s.notifier.Enqueue(ctx, schema.OutgoingMessage{
+		ChatID: done.AuthorID,
+		Parts: []schema.LocalizedText{{
+			Key:  "broadcast.report",
+			Args: []any{done.Stats.Total, done.Stats.Sent, done.Stats.Blocked, done.Stats.Failed},
+		}},
+	})
+}
+
+func recipientStatus(err error) (schema.RecipientStatus, string) {
+	switch {
+	case err == nil:
+		return schema.RecipientStatusSent, ""
+	case errors.Is(err, errorz.ErrForbidden):
+		return schema.RecipientStatusBlocked, err.Error()
+	default:
+		return schema.RecipientStatusFailed, err.Error()
+	}
+}
+
+func normalizeDraft(draft schema.BroadcastDraft) (schema.BroadcastDraft, error) {
+	draft.Text = strings.TrimSpace(draft.Text)
+	if draft.Text == "" || !draft.Audience.Valid() {
+		return schema.BroadcastDraft{}, errorz.ErrInvalid
+	}
+	if utf8.RuneCountInString(draft.Text) > MaxTextLen {
+		return schema.BroadcastDraft{}, errorz.ErrLimitExceeded
+	}
+	if draft.Audience != schema.BroadcastAudienceActive {
+		draft.ActiveDays = 0
+	} else if draft.ActiveDays < 1 || draft.ActiveDays > MaxActiveDays {
+		return schema.BroadcastDraft{}, errorz.ErrInvalid
+	}
+	return draft, nil
+}
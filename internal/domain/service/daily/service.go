@@ -0,0 +1,141 @@
+package daily
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"LoudQuestionBot/internal/domain/service/notify"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+type Service struct {
+	daily    repository.DailyQuestionRepository
+	users    repository.UserRepository
+	notifier *notify.Service
+
+	at  time.Duration
+	loc *time.Location
+}
+
+func New(daily repository.DailyQuestionRepository, users repository.UserRepository, notifier *notify.Service, at time.Duration, loc *time.Location) *Service {
+	s := &Service{daily: daily, users: users, notifier: notifier, at: at, loc: loc}
+	notifier.OnBlocked(func(ctx context.Context, chatID int64) {
+		if err := s.users.SetDailyQuestion(ctx, chatID, false); err != nil {
+			log.Printf("disable daily question for blocked chat %d: %v", chatID, err)
+		}
+	})
+	return s
+}
+
+func (s *Service) SendTime() string {
+	return time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(s.at).Format("15:04")
+}
+
+func (s *Service) Today() string {
+	return time.Now().In(s.loc).Format(schema.DayLayout)
+}
+
+func (s *Service) Question(ctx context.Context, day string, language schema.Language) (schema.DailyQuestion, error) {
+	return s.daily.GetByDay(ctx, day, language)
+}
+
+func (s *Service) Answer(ctx context.Context, day string, language schema.Language, userID int64, knew bool) error {
+	if _, err := s.daily.GetByDay(ctx, day, language); err != nil {
+		return err
+	}
+	return s.daily.SaveAnswer(ctx, day, language, userID, knew)
+}
+
+func (s *Service) Start(ctx context.Context) {
+	for {
+		now := time.Now().In(s.loc)
+		runAt := s.runAt(now)
+		if !now.Before(runAt) {
+			if err := s.publish(ctx, now); err != nil && ctx.Err() == nil {
+				log.Printf("daily question: %v", err)
+			}
+			runAt = s.runAt(now.AddDate(0, 0, 1))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Until(runAt)):
+		}
+	}
+}
+
+func (s *Service) runAt(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, s.loc).Add(s.at)
+}
+
+func (s *Service) publish(ctx context.Context, now time.Time) error {
+	users, err := s.users.ListDailyQuestionUsers(ctx)
+	if err != nil {
+		return err
+	}
+	byLanguage := make(map[schema.Language][]int64, len(schema.Languages))
+	for _, u := range users {
+		lang := u.PreferredLanguage()
+		byLanguage[lang] = append(byLanguage[lang], u.UserID)
+	}
+	for _, lang := range schema.Languages {
+		if err := s.publishLanguage(ctx, now, lang, byLanguage[lang]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) publishLanguage(ctx context.Context, now time.Time, lang schema.Language, userIDs []int64) error {
+	dq, err := s.daily.CreateForDay(ctx, now.Format(schema.DayLayout), lang)
+	if errors.Is(err, errorz.ErrAlreadyExists) {
+		return nil
+	}
+	if errors.Is(err, errorz.ErrNotFound) {
+		log.Printf("daily question: no unused active %s questions left", lang)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var summary *schema.DailySummary
+	yesterday, err := s.daily.Summary(ctx, now.AddDate(0, 0, -1).Format(schema.DayLayout), lang)
+	switch {
+	case err == nil:
+		summary = &yesterday
+	case !errors.Is(err, errorz.ErrNotFound):
+		return err
+	}
+
+	parts := dailyText(dq, summary)
+	for _, userID := range userIDs {
+		if err := s.notifier.Enqueue(ctx, schema.OutgoingMessage{
+			ChatID: userID,
+			Parts:  parts,
+			Buttons: [][]schema.OutgoingButton{
+				{{Label: schema.LocalizedText{Key: "daily.show_answer"}, CallbackData: "day:ans:" + dq.Day + ":" + string(dq.Language)}},
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	log.Printf("daily question %s (%s) queued for %d users", dq.Day, lang, len(userIDs))
+	return nil
+}
+
+func dailyText(dq schema.DailyQuestion, summary *schema.DailySummary) []schema.LocalizedText {
+	var parts []schema.LocalizedText
+	if summary != nil {
+		parts = append(parts, schema.LocalizedText{Key: "daily.summary", Args: []any{summary.Question.QuestionText, summary.Question.AnswerText}})
+		if summary.Answered == 0 {
+			parts = append(parts, schema.LocalizedText{Key: "daily.summary_nobody"})
+		} else {
+			parts = append(parts, schema.LocalizedText{Key: "daily.summary_knew", Args: []any{summary.Knew, summary.Answered}})
+		}
+	}
+	return append(parts, schema.LocalizedText{Key: "daily.question", Args: []any{dq.Question.QuestionText}})
+}
@@ -14,12 +14,47 @@ func New(repo repository.FormStateRepository) *Service {
 	return &Service{repo: repo}
 }
 
-func (s *Service) StartCreate(ctx context.Context, userID int64) error {
-	return s.repo.Set(ctx, userID, schema.FormState{Mode: schema.FormModeCreate, Step: schema.FormStepQuestion})
+func (s *Service) StartCreate(ctx context.Context, userID int64, lang schema.Language) error {
+	return s.repo.Set(ctx, userID, schema.FormState{
+		Mode:  schema.FormModeCreate,
+		Step:  schema.FormStepQuestion,
+		Draft: schema.QuestionDraft{Language: lang},
+	})
+}
+
+func (s *Service) StartTranslation(ctx context.Context, userID int64, source schema.Question, lang schema.Language) error {
+	return s.repo.Set(ctx, userID, schema.FormState{
+		Mode: schema.FormModeCreate,
+		Step: schema.FormStepQuestion,
+		Draft: schema.QuestionDraft{
+			Category:      source.Category,
+			Difficulty:    source.Difficulty,
+			Language:      lang,
+			TranslationOf: source.ID,
+		},
+	})
 }
 
-func (s *Service) StartPoolCreate(ctx context.Context, userID int64) error {
-	return s.repo.Set(ctx, userID, schema.FormState{Mode: schema.FormModeCreate, Step: schema.FormStepPoolInput})
+func (s *Service) StartPoolCreate(ctx context.Context, userID int64, lang schema.Language, packID string) error {
+	return s.repo.Set(ctx, userID, schema.FormState{
+		Mode:  schema.FormModeCreate,
+		Step:  schema.FormStepPoolInput,
+		Draft: schema.QuestionDraft{Language: lang, PackID: packID},
+	})
+}
+
+func (s *Service) StartPack(ctx context.Context, userID int64) error {
+	return s.repo.Set(ctx, userID, schema.FormState{
+		Mode: schema.FormModePack,
+		Step: schema.FormStepPackTitle,
+	})
+}
+
+func (s *Service) StartSearch(ctx context.Context, userID int64) error {
+	return s.repo.Set(ctx, userID, schema.FormState{
+		Mode: schema.FormModeSearch,
+		Step: schema.FormStepSearchQuery,
+	})
 }
 
 func (s *Service) StartEdit(ctx context.Context, userID int64, questionID string, page int, draft schema.QuestionDraft) error {
@@ -32,6 +67,68 @@ func (s *Service) StartEdit(ctx context.Context, userID int64, questionID string
 	})
 }
 
+func (s *Service) StartSuggest(ctx context.Context, userID int64, lang schema.Language) error {
+	return s.repo.Set(ctx, userID, schema.FormState{
+		Mode:  schema.FormModeSuggest,
+		Step:  schema.FormStepQuestion,
+		Draft: schema.QuestionDraft{Language: lang},
+	})
+}
+
+func (s *Service) StartModerateEdit(ctx context.Context, userID int64, questionID string, page int, draft schema.QuestionDraft) error {
+	return s.repo.Set(ctx, userID, schema.FormState{
+		Mode:       schema.FormModeModerate,
+		Step:       schema.FormStepChooseField,
+		QuestionID: questionID,
+		Page:       page,
+		Draft:      draft,
+	})
+}
+
+func (s *Service) StartReject(ctx context.Context, userID int64, questionID string, page int) error {
+	return s.repo.Set(ctx, userID, schema.FormState{
+		Mode:       schema.FormModeModerate,
+		Step:       schema.FormStepRejectReason,
+		QuestionID: questionID,
+		Page:       page,
+	})
+}
+
+func (s *Service) StartReviewEdit(ctx context.Context, userID int64, questionID string, page int, draft schema.QuestionDraft) error {
+	return s.repo.Set(ctx, userID, schema.FormState{
+		Mode:       schema.FormModeReview,
+		Step:       schema.FormStepChooseField,
+		QuestionID: questionID,
+		Page:       page,
+		Draft:      draft,
+	})
+}
+
+func (s *Service) StartManageEdit(ctx context.Context, userID int64, questionID string, authorID int64, page int, draft schema.QuestionDraft) error {
+	return s.repo.Set(ctx, userID, schema.FormState{
+		Mode:       schema.FormModeManage,
+		Step:       schema.FormStepChooseField,
+		QuestionID: questionID,
+		AuthorID:   authorID,
+		Page:       page,
+		Draft:      draft,
+	})
+}
+
+func (s *Service) StartReassign(ctx context.Context, userID int64, questionID string, authorID int64, page int) error {
+	return s.repo.Set(ctx, userID, schema.FormState{
+		Mode:       schema.FormModeManage,
+		Step:       schema.FormStepReassignAuthor,
+		QuestionID: questionID,
+		AuthorID:   authorID,
+		Page:       page,
+	})
+}
+
+func (s *Service) StartBroadcast(ctx context.Context, userID int64) error {
+	return s.repo.Set(ctx, userID, schema.FormState{Mode: schema.FormModeBroadcast, Step: schema.FormStepBroadcastText})
+}
+
 func (s *Service) Get(ctx context.Context, userID int64) (schema.FormState, bool, error) {
 	return s.repo.Get(ctx, userID)
 }
@@ -6,27 +6,59 @@ import (
 	"LoudQuestionBot/internal/domain/schema"
 	"context"
 	"errors"
+	"math/rand/v2"
 )
 
 var ErrNoNewQuestions = errors.New("no new questions")
 
+const (
+	sampleSize      = 16
+	autoHideReports = 3
+)
+
 type Service struct {
 	questions repository.QuestionRepository
+	settings  repository.PlaySettingsRepository
+	packs     repository.PackRepository
+	scores    repository.ScoreRepository
+	reports   repository.ReportRepository
+	ratings   repository.RatingRepository
 }
 
-func New(questions repository.QuestionRepository) *Service {
-	return &Service{questions: questions}
+func New(questions repository.QuestionRepository, settings repository.PlaySettingsRepository, packs repository.PackRepository, scores repository.ScoreRepository, reports repository.ReportRepository, ratings repository.RatingRepository) *Service {
+	return &Service{questions: questions, settings: settings, packs: packs, scores: scores, reports: reports, ratings: ratings}
 }
 
-func (s *Service) NextQuestion(ctx context.Context, userID int64, teamID string) (schema.Question, error) {
-	var (
-		q   schema.Question
-		err error
-	)
-	if teamID == "" {
-		q, err = s.questions.GetActiveUnseenByUser(ctx, userID)
-	} else {
-		q, err = s.questions.GetActiveUnseenByTeam(ctx, teamID, userID)
+func (s *Service) NextQuestion(ctx context.Context, userID int64, teamID string, lang schema.Language) (schema.Question, error) {
+	return s.next(ctx, schema.PlayScope{UserID: userID, TeamID: teamID}, lang, func(filter schema.QuestionFilter, pick repository.QuestionPicker) (schema.Question, error) {
+		if teamID == "" {
+			return s.questions.DrawUnseenByUser(ctx, userID, filter, sampleSize, pick)
+		}
+		return s.questions.DrawUnseenByTeam(ctx, teamID, userID, filter, sampleSize, pick)
+	})
+}
+
+func (s *Service) NextChatQuestion(ctx context.Context, chatID, hostID int64, hostTeamID string, lang schema.Language) (schema.Question, error) {
+	return s.next(ctx, schema.PlayScope{UserID: hostID, TeamID: hostTeamID}, lang, func(filter schema.QuestionFilter, pick repository.QuestionPicker) (schema.Question, error) {
+		return s.questions.DrawUnseenByChat(ctx, chatID, hostID, filter, sampleSize, pick)
+	})
+}
+
+func (s *Service) next(ctx context.Context, settingsScope schema.PlayScope, lang schema.Language, draw func(filter schema.QuestionFilter, pick repository.QuestionPicker) (schema.Question, error)) (schema.Question, error) {
+	settings, err := s.settings.Get(ctx, settingsScope)
+	if err != nil {
+		return schema.Question{}, err
+	}
+	filter := settings.Filter()
+	filter.Language = settings.QuestionLanguage(lang)
+
+	pick := func(candidates []schema.Question) schema.Question {
+		return pickQuestion(candidates, filter)
+	}
+	q, err := draw(filter, pick)
+	if errors.Is(err, errorz.ErrNotFound) && settings.LanguageFallback {
+		filter.Language = schema.LanguageAuto
+		q, err = draw(filter, pick)
 	}
 	if err != nil {
 		if errors.Is(err, errorz.ErrNotFound) {
@@ -34,19 +66,178 @@ func (s *Service) NextQuestion(ctx context.Context, userID int64, teamID string)
 		}
 		return schema.Question{}, err
 	}
-	if teamID == "" {
-		if err := s.questions.MarkSeenByUser(ctx, userID, q.ID); err != nil {
-			return schema.Question{}, err
+	return q, nil
+}
+
+func (s *Service) ReportQuestion(ctx context.Context, userID int64, questionID string, reason schema.ReportReason) (bool, error) {
+	if !reason.Valid() {
+		return false, errorz.ErrInvalid
+	}
+	if _, err := s.ActiveQuestionByID(ctx, questionID); err != nil {
+		return false, err
+	}
+	if err := s.reports.Create(ctx, schema.QuestionReport{QuestionID: questionID, UserID: userID, Reason: reason}); err != nil {
+		return false, err
+	}
+	cnt, err := s.reports.CountOpen(ctx, questionID)
+	if err != nil {
+		return false, err
+	}
+	if cnt < autoHideReports {
+		return false, nil
+	}
+	if err := s.questions.SetReviewedStatus(ctx, questionID, schema.QuestionStatusHidden); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *Service) RateQuestion(ctx context.Context, userID int64, questionID string, value schema.RatingValue) error {
+	if !value.Valid() {
+		return errorz.ErrInvalid
+	}
+	q, err := s.ActiveQuestionByID(ctx, questionID)
+	if err != nil {
+		return err
+	}
+	if q.AuthorID == userID {
+		return errorz.ErrForbidden
+	}
+	return s.ratings.Rate(ctx, schema.QuestionRating{QuestionID: questionID, UserID: userID, Value: value})
+}
+
+func (s *Service) ResetProgress(ctx context.Context, scope schema.PlayScope) (int, error) {
+	if scope.IsChat() {
+		return s.questions.ResetSeenByChat(ctx, scope.ChatID)
+	}
+	if scope.IsTeam() {
+		return s.questions.ResetSeenByTeam(ctx, scope.TeamID)
+	}
+	return s.questions.ResetSeenByUser(ctx, scope.UserID)
+}
+
+func (s *Service) Settings(ctx context.Context, scope schema.PlayScope) (schema.PlaySettings, error) {
+	return s.settings.Get(ctx, scope)
+}
+
+func (s *Service) ToggleCategory(ctx context.Context, scope schema.PlayScope, category schema.QuestionCategory) (schema.PlaySettings, error) {
+	if !category.Valid() {
+		return schema.PlaySettings{}, errorz.ErrInvalid
+	}
+	settings, err := s.settings.Get(ctx, scope)
+	if err != nil {
+		return schema.PlaySettings{}, err
+	}
+	next := make([]schema.QuestionCategory, 0, len(settings.Categories)+1)
+	found := false
+	for _, c := range settings.Categories {
+		if c == category {
+			found = true
+			continue
 		}
-	} else {
-		if err := s.questions.MarkSeenByTeam(ctx, teamID, q.ID); err != nil {
-			return schema.Question{}, err
+		next = append(next, c)
+	}
+	if !found {
+		next = append(next, category)
+	}
+	settings.Categories = next
+	if err := s.settings.Save(ctx, scope, settings); err != nil {
+		return schema.PlaySettings{}, err
+	}
+	return settings, nil
+}
+
+func (s *Service) ResetCategories(ctx context.Context, scope schema.PlayScope) (schema.PlaySettings, error) {
+	settings, err := s.settings.Get(ctx, scope)
+	if err != nil {
+		return schema.PlaySettings{}, err
+	}
+	settings.Categories = nil
+	if err := s.settings.Save(ctx, scope, settings); err != nil {
+		return schema.PlaySettings{}, err
+	}
+	return settings, nil
+}
+
+func (s *Service) TogglePack(ctx context.Context, scope schema.PlayScope, packID string) (schema.PlaySettings, error) {
+	settings, err := s.settings.Get(ctx, scope)
+	if err != nil {
+		return schema.PlaySettings{}, err
+	}
+	next := make([]string, 0, len(settings.Packs)+1)
+	for _, id := range settings.Packs {
+		if id != packID {
+			next = append(next, id)
 		}
 	}
-	return q, nil
+	if !settings.HasPack(packID) {
+		if _, err := s.packs.GetByID(ctx, packID); err != nil {
+			return schema.PlaySettings{}, err
+		}
+		next = append(next, packID)
+	}
+	settings.Packs = next
+	if err := s.settings.Save(ctx, scope, settings); err != nil {
+		return schema.PlaySettings{}, err
+	}
+	return settings, nil
 }
 
-func (s *Service) TeamAnsweredCount(ctx context.Context, teamID string) (int, error) {
+func (s *Service) ResetPacks(ctx context.Context, scope schema.PlayScope) (schema.PlaySettings, error) {
+	settings, err := s.settings.Get(ctx, scope)
+	if err != nil {
+		return schema.PlaySettings{}, err
+	}
+	settings.Packs = nil
+	if err := s.settings.Save(ctx, scope, settings); err != nil {
+		return schema.PlaySettings{}, err
+	}
+	return settings, nil
+}
+
+func (s *Service) SetDifficulty(ctx context.Context, scope schema.PlayScope, difficulty schema.QuestionDifficulty) (schema.PlaySettings, error) {
+	if !difficulty.ValidChoice() {
+		return schema.PlaySettings{}, errorz.ErrInvalid
+	}
+	settings, err := s.settings.Get(ctx, scope)
+	if err != nil {
+		return schema.PlaySettings{}, err
+	}
+	settings.Difficulty = difficulty
+	if err := s.settings.Save(ctx, scope, settings); err != nil {
+		return schema.PlaySettings{}, err
+	}
+	return settings, nil
+}
+
+func (s *Service) SetLanguage(ctx context.Context, scope schema.PlayScope, lang schema.Language) (schema.PlaySettings, error) {
+	if !lang.Valid() {
+		return schema.PlaySettings{}, errorz.ErrInvalid
+	}
+	settings, err := s.settings.Get(ctx, scope)
+	if err != nil {
+		return schema.PlaySettings{}, err
+	}
+	settings.Language = lang
+	if err := s.settings.Save(ctx, scope, settings); err != nil {
+		return schema.PlaySettings{}, err
+	}
+	return settings, nil
+}
+
+func (s *Service) ToggleLanguageFallback(ctx context.Context, scope schema.PlayScope) (schema.PlaySettings, error) {
+	settings, err := s.settings.Get(ctx, scope)
+	if err != nil {
+		return schema.PlaySettings{}, err
+	}
+	settings.LanguageFallback = !settings.LanguageFallback
+	if err := s.settings.Save(ctx, scope, settings); err != nil {
+		return schema.PlaySettings{}, err
+	}
+	return settings, nil
+}
+
+func (s *Service) TeamSeenCount(ctx context.Context, teamID string) (int, error) {
 	if teamID == "" {
 		return 0, nil
 	}
@@ -57,13 +248,21 @@ func (s *Service) TeamAnsweredCount(ctx context.Context, teamID string) (int, er
 	return cnt, nil
 }
 
-func (s *Service) AnswerByQuestionID(ctx context.Context, questionID string) (string, error) {
+func (s *Service) ActiveQuestionByID(ctx context.Context, questionID string) (schema.Question, error) {
 	q, err := s.questions.GetByID(ctx, questionID)
 	if err != nil {
-		return "", err
+		return schema.Question{}, err
 	}
 	if q.Status != schema.QuestionStatusActive {
-		return "", errorz.ErrNotFound
+		return schema.Question{}, errorz.ErrNotFound
+	}
+	return q, nil
+}
+
+func (s *Service) AnswerByQuestionID(ctx context.Context, questionID string) (string, error) {
+	q, err := s.ActiveQuestionByID(ctx, questionID)
+	if err != nil {
+		return "", err
 	}
 	return q.AnswerText, nil
 }
@@ -75,3 +274,78 @@ func (s *Service) MarkAnsweredByUser(ctx context.Context, userID int64, question
 func (s *Service) AnsweredByUserCount(ctx context.Context, userID int64) (int, error) {
 	return s.questions.CountAnsweredByUser(ctx, userID)
 }
+
+func (s *Service) IsScoredByTeam(ctx context.Context, teamID, questionID string) (bool, error) {
+	return s.scores.IsScoredByTeam(ctx, teamID, questionID)
+}
+
+func (s *Service) ScoreTeamQuestion(ctx context.Context, teamID, questionID string, scoredBy, winnerID int64) error {
+	seen, err := s.questions.IsSeenByTeam(ctx, teamID, questionID)
+	if err != nil {
+		return err
+	}
+	if !seen {
+		return errorz.ErrNotFound
+	}
+	return s.scores.RecordTeamScore(ctx, schema.QuestionScore{
+		TeamID:     teamID,
+		QuestionID: questionID,
+		UserID:     winnerID,
+		ScoredBy:   scoredBy,
+	})
+}
+
+func (s *Service) TeamStats(ctx context.Context, t schema.Team, members []schema.TeamMember) (schema.TeamWithMembers, error) {
+	seen, err := s.questions.CountSeenByTeam(ctx, t.ID)
+	if err != nil {
+		return schema.TeamWithMembers{}, err
+	}
+	total, err := s.scores.CountTeamScore(ctx, t.ID)
+	if err != nil {
+		return schema.TeamWithMembers{}, err
+	}
+	perMember, err := s.scores.TeamMemberScores(ctx, t.ID)
+	if err != nil {
+		return schema.TeamWithMembers{}, err
+	}
+	out := schema.TeamWithMembers{Team: t, SeenCnt: seen, Score: total, Members: make([]schema.TeamMember, 0, len(members))}
+	for _, m := range members {
+		m.Score = perMember[m.UserID]
+		out.Members = append(out.Members, m)
+	}
+	return out, nil
+}
+
+func (s *Service) UserScore(ctx context.Context, userID int64) (int, error) {
+	return s.scores.CountUserScore(ctx, userID)
+}
+
+func pickQuestion(candidates []schema.Question, filter schema.QuestionFilter) schema.Question {
+	if filter.Difficulty.Valid() {
+		return pickWeighted(candidates)
+	}
+	byLevel := make(map[schema.QuestionDifficulty][]schema.Question, len(schema.QuestionDifficulties))
+	levels := make([]schema.QuestionDifficulty, 0, len(schema.QuestionDifficulties))
+	for _, q := range candidates {
+		if _, ok := byLevel[q.Difficulty]; !ok {
+			levels = append(levels, q.Difficulty)
+		}
+		byLevel[q.Difficulty] = append(byLevel[q.Difficulty], q)
+	}
+	return pickWeighted(byLevel[levels[rand.IntN(len(levels))]])
+}
+
+func pickWeighted(candidates []schema.Question) schema.Question {
+	total := 0.0
+	for _, q := range candidates {
+		total += q.RatingWeight()
+	}
+	r := rand.Float64() * total
+	for _, q := range candidates {
+		r -= q.RatingWeight()
+		if r < 0 {
+			return q
+		}
+	}
+	return candidates[len(candidates)-1]
+}
@@ -0,0 +1,81 @@
+package group
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/repository"
+	"context"
+)
+
+type Service struct {
+	repo repository.GroupRepository
+}
+
+func New(repo repository.GroupRepository) *Service {
+	return &Service{repo: repo}
+}
+
+func (s *Service) Host(ctx context.Context, chatID int64) (int64, bool, error) {
+	return s.repo.GetHost(ctx, chatID)
+}
+
+func (s *Service) IsHost(ctx context.Context, chatID, userID int64) (bool, error) {
+	hostID, ok, err := s.repo.GetHost(ctx, chatID)
+	if err != nil {
+		return false, err
+	}
+	return ok && hostID == userID, nil
+}
+
+func (s *Service) BecomeHost(ctx context.Context, chatID, userID int64) error {
+	for attempt := 0; attempt < 2; attempt++ {
+		set, err := s.repo.SetHostIfAbsent(ctx, chatID, userID)
+		if err != nil {
+			return err
+		}
+		if set {
+			return nil
+		}
+		hostID, ok, err := s.repo.GetHost(ctx, chatID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		if hostID == userID {
+			return errorz.ErrAlreadyExists
+		}
+		return errorz.ErrConflict
+	}
+	return errorz.ErrConflict
+}
+
+func (s *Service) TakeHost(ctx context.Context, chatID, userID int64) error {
+	return s.repo.SetHost(ctx, chatID, userID)
+}
+
+func (s *Service) ClearHost(ctx context.Context, chatID int64) error {
+	return s.repo.DeleteHost(ctx, chatID)
+}
+
+func (s *Service) PassHost(ctx context.Context, chatID, hostID, newHostID int64) error {
+	isHost, err := s.IsHost(ctx, chatID, hostID)
+	if err != nil {
+		return err
+	}
+	if !isHost {
+		return errorz.ErrForbidden
+	}
+	return s.repo.SetHost(ctx, chatID, newHostID)
+}
+
+func (s *Service) ReleaseHost(ctx context.Context, chatID, userID int64) error {
+	isHost, err := s.IsHost(ctx, chatID, userID)
+	if err != nil {
+		return err
+	}
+	if !isHost {
+		return errorz.ErrForbidden
+	}
+	return s.repo.DeleteHost(ctx, chatID)
+}
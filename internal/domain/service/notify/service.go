@@ -0,0 +1,119 @@
+package notify
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/schema"
+	"LoudQuestionBot/internal/domain/service/telegram"
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	queueSize    = 1024
+	sendInterval = 40 * time.Millisecond
+	maxAttempts  = 3
+)
+
+type BlockedHook func(ctx context.Context, chatID int64)
+
+type job struct {
+	msg  schema.OutgoingMessage
+	done func(err error)
+}
+
+type Service struct {
+	queue chan job
+
+	mu      sync.RWMutex
+	blocked []BlockedHook
+}
+
+func New() *Service {
+	return &Service{queue: make(chan job, queueSize)}
+}
+
+func (s *Service) Enqueue(ctx context.Context, msg schema.OutgoingMessage) error {
+	return s.EnqueueWithResult(ctx, msg, nil)
+}
+
+func (s *Service) EnqueueWithResult(ctx context.Context, msg schema.OutgoingMessage, done func(err error)) error {
+	select {
+	case s.queue <- job{msg: msg, done: done}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Service) OnBlocked(hook BlockedHook) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blocked = append(s.blocked, hook)
+}
+
+func (s *Service) Worker(sender telegram.Sender) *Worker {
+	return &Worker{svc: s, sender: sender}
+}
+
+type Worker struct {
+	svc    *Service
+	sender telegram.Sender
+}
+
+func (w *Worker) Start(ctx context.Context) {
+	ticker := time.NewTicker(sendInterval)
+	defer ticker.Stop()
+	for {
+		var j job
+		select {
+		case <-ctx.Done():
+			return
+		case j = <-w.svc.queue:
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		err := w.deliver(ctx, j.msg)
+		if j.done != nil {
+			j.done(err)
+		}
+	}
+}
+
+func (w *Worker) deliver(ctx context.Context, msg schema.OutgoingMessage) error {
+	for attempt := 1; ; attempt++ {
+		err := w.sender.Send(ctx, msg)
+		if err == nil {
+			return nil
+		}
+		var retry *telegram.RetryAfterError
+		if errors.As(err, &retry) && attempt < maxAttempts {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(retry.After):
+			}
+			continue
+		}
+		if errors.Is(err, errorz.ErrForbidden) {
+			w.svc.notifyBlocked(ctx, msg.ChatID)
+			return err
+		}
+		log.Printf("notify chat %d: %v", msg.ChatID, err)
+		return err
+	}
+}
+
+func (s *Service) notifyBlocked(ctx context.Context, chatID int64) {
+	s.mu.RLock()
+	hooks := append([]BlockedHook(nil), s.blocked...)
+	s.mu.RUnlock()
+	for _, hook := range hooks {
+		hook(ctx, chatID)
+	}
+}
@@ -0,0 +1,47 @@
+package pack
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	MaxTitleLen       = 64
+	MaxDescriptionLen = 500
+)
+
+type Service struct {
+	packs repository.PackRepository
+}
+
+func New(packs repository.PackRepository) *Service {
+	return &Service{packs: packs}
+}
+
+func (s *Service) Create(ctx context.Context, authorID int64, draft schema.PackDraft) (schema.Pack, error) {
+	draft.Title = strings.TrimSpace(draft.Title)
+	draft.Description = strings.TrimSpace(draft.Description)
+	if draft.Title == "" {
+		return schema.Pack{}, errorz.ErrInvalid
+	}
+	if utf8.RuneCountInString(draft.Title) > MaxTitleLen || utf8.RuneCountInString(draft.Description) > MaxDescriptionLen {
+		return schema.Pack{}, errorz.ErrLimitExceeded
+	}
+	return s.packs.Create(ctx, authorID, draft)
+}
+
+func (s *Service) Get(ctx context.Context, id string) (schema.Pack, error) {
+	return s.packs.GetByID(ctx, id)
+}
+
+func (s *Service) List(ctx context.Context) ([]schema.Pack, error) {
+	return s.packs.List(ctx)
+}
+
+func (s *Service) Delete(ctx context.Context, id string) error {
+	return s.packs.Delete(ctx, id)
+}
@@ -0,0 +1,171 @@
+package session
+
+import (
+	"LoudQuestionBot/internal/domain/errorz"
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"errors"
+	"time"
+)
+
+const idleTimeout = 6 * time.Hour
+
+var ErrNoActiveSession = errors.New("no active session")
+
+type Service struct {
+	sessions repository.GameSessionRepository
+}
+
+func New(sessions repository.GameSessionRepository) *Service {
+	return &Service{sessions: sessions}
+}
+
+func (s *Service) Start(ctx context.Context, chatID, startedBy int64, teamID string, roundLimit int, duration time.Duration) (schema.GameSession, error) {
+	if roundLimit < 0 || duration < 0 {
+		return schema.GameSession{}, errorz.ErrInvalid
+	}
+	active, ok, err := s.active(ctx, chatID)
+	if err != nil {
+		return schema.GameSession{}, err
+	}
+	if ok && len(active.Rounds) > 0 {
+		return schema.GameSession{}, errorz.ErrConflict
+	}
+	if ok {
+		if err := s.sessions.Finish(ctx, active.ID); err != nil {
+			return schema.GameSession{}, err
+		}
+	}
+	return s.sessions.Create(ctx, schema.GameSession{
+		ChatID:     chatID,
+		StartedBy:  startedBy,
+		TeamID:     teamID,
+		RoundLimit: roundLimit,
+		Duration:   duration,
+		Status:     schema.GameSessionStatusActive,
+	})
+}
+
+func (s *Service) Active(ctx context.Context, chatID int64) (schema.GameSession, bool, error) {
+	return s.active(ctx, chatID)
+}
+
+func (s *Service) BeforeDraw(ctx context.Context, chatID int64) (schema.GameSummary, bool, error) {
+	active, ok, err := s.active(ctx, chatID)
+	if err != nil || !ok {
+		return schema.GameSummary{}, false, err
+	}
+	if !active.LimitReached(time.Now()) {
+		return schema.GameSummary{}, false, nil
+	}
+	summary, err := s.finish(ctx, active)
+	if err != nil {
+		return schema.GameSummary{}, false, err
+	}
+	return summary, true, nil
+}
+
+func (s *Service) RecordDraw(ctx context.Context, chatID, userID int64, teamID, questionID string) error {
+	active, ok, err := s.active(ctx, chatID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		active, err = s.sessions.Create(ctx, schema.GameSession{
+			ChatID:    chatID,
+			StartedBy: userID,
+			TeamID:    teamID,
+			Status:    schema.GameSessionStatusActive,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return s.sessions.AddRound(ctx, active.ID, questionID)
+}
+
+func (s *Service) RecordReveal(ctx context.Context, chatID int64, questionID string) error {
+	active, ok, err := s.active(ctx, chatID)
+	if err != nil || !ok {
+		return err
+	}
+	return s.sessions.MarkRoundRevealed(ctx, active.ID, questionID)
+}
+
+func (s *Service) RecordScore(ctx context.Context, chatID int64, questionID string, userID int64) error {
+	active, ok, err := s.active(ctx, chatID)
+	if err != nil || !ok {
+		return err
+	}
+	return s.sessions.SetRoundScorer(ctx, active.ID, questionID, userID)
+}
+
+func (s *Service) End(ctx context.Context, chatID int64) (schema.GameSummary, error) {
+	active, ok, err := s.active(ctx, chatID)
+	if err != nil {
+		return schema.GameSummary{}, err
+	}
+	if !ok {
+		return schema.GameSummary{}, ErrNoActiveSession
+	}
+	return s.finish(ctx, active)
+}
+
+func (s *Service) History(ctx context.Context, chatID int64, limit int) ([]schema.GameSummary, error) {
+	sessions, err := s.sessions.ListFinishedByChat(ctx, chatID, limit)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]schema.GameSummary, 0, len(sessions))
+	for _, gs := range sessions {
+		out = append(out, Summarize(gs))
+	}
+	return out, nil
+}
+
+func (s *Service) active(ctx context.Context, chatID int64) (schema.GameSession, bool, error) {
+	active, ok, err := s.sessions.GetActiveByChat(ctx, chatID)
+	if err != nil || !ok {
+		return schema.GameSession{}, false, err
+	}
+	if time.Since(active.LastActivityAt()) > idleTimeout {
+		if err := s.sessions.Finish(ctx, active.ID); err != nil {
+			return schema.GameSession{}, false, err
+		}
+		return schema.GameSession{}, false, nil
+	}
+	return active, true, nil
+}
+
+func (s *Service) finish(ctx context.Context, gs schema.GameSession) (schema.GameSummary, error) {
+	if err := s.sessions.Finish(ctx, gs.ID); err != nil {
+		return schema.GameSummary{}, err
+	}
+	finished, err := s.sessions.GetByID(ctx, gs.ID)
+	if err != nil {
+		return schema.GameSummary{}, err
+	}
+	return Summarize(finished), nil
+}
+
+func Summarize(gs schema.GameSession) schema.GameSummary {
+	out := schema.GameSummary{Session: gs, Played: len(gs.Rounds), Scores: make(map[int64]int)}
+	for _, r := range gs.Rounds {
+		if r.Revealed {
+			out.Revealed++
+		}
+		if r.ScorerID != 0 {
+			out.Scores[r.ScorerID]++
+		}
+	}
+	end := gs.EndedAt
+	if end.IsZero() {
+		end = time.Now()
+	}
+	if last := gs.LastActivityAt(); end.Sub(last) > idleTimeout {
+		end = last
+	}
+	out.Elapsed = end.Sub(gs.StartedAt)
+	return out
+}
@@ -0,0 +1,154 @@
+package subscription
+
+import (
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"LoudQuestionBot/internal/domain/service/notify"
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+const (
+	Threshold  = 5
+	checkDelay = 30 * time.Second
+)
+
+type Service struct {
+	subs      repository.SubscriptionRepository
+	questions repository.QuestionRepository
+	settings  repository.PlaySettingsRepository
+	teams     repository.TeamRepository
+	users     repository.UserRepository
+	notifier  *notify.Service
+
+	added chan struct{}
+}
+
+func New(subs repository.SubscriptionRepository, questions repository.QuestionRepository, settings repository.PlaySettingsRepository, teams repository.TeamRepository, users repository.UserRepository, notifier *notify.Service) *Service {
+	s := &Service{
+		subs:      subs,
+		questions: questions,
+		settings:  settings,
+		teams:     teams,
+		users:     users,
+		notifier:  notifier,
+		added:     make(chan struct{}, 1),
+	}
+	notifier.OnBlocked(func(ctx context.Context, chatID int64) {
+		if err := s.subs.Unsubscribe(ctx, chatID); err != nil {
+			log.Printf("unsubscribe blocked chat %d: %v", chatID, err)
+		}
+	})
+	return s
+}
+
+func (s *Service) Subscribe(ctx context.Context, userID int64) error {
+	return s.subs.Subscribe(ctx, userID)
+}
+
+func (s *Service) Unsubscribe(ctx context.Context, userID int64) error {
+	return s.subs.Unsubscribe(ctx, userID)
+}
+
+func (s *Service) IsSubscribed(ctx context.Context, userID int64) (bool, error) {
+	return s.subs.IsSubscribed(ctx, userID)
+}
+
+func (s *Service) QuestionsAdded() {
+	select {
+	case s.added <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Service) Start(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.added:
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(checkDelay):
+		}
+		if err := s.notifySubscribers(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("notify subscribers: %v", err)
+		}
+	}
+}
+
+func (s *Service) notifySubscribers(ctx context.Context) error {
+	userIDs, err := s.subs.ListSubscribers(ctx)
+	if err != nil {
+		return err
+	}
+	failed := 0
+	for _, userID := range userIDs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := s.notifySubscriber(ctx, userID); err != nil {
+			log.Printf("notify subscriber %d: %v", userID, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d subscribers failed", failed, len(userIDs))
+	}
+	return nil
+}
+
+func (s *Service) notifySubscriber(ctx context.Context, userID int64) error {
+	cnt, err := s.unseenCount(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if cnt < Threshold {
+		return nil
+	}
+	if err := s.notifier.Enqueue(ctx, newQuestionsMessage(userID)); err != nil {
+		return err
+	}
+	return s.subs.Unsubscribe(ctx, userID)
+}
+
+func (s *Service) unseenCount(ctx context.Context, userID int64) (int, error) {
+	scope := schema.PlayScope{UserID: userID}
+	t, ok, err := s.teams.GetByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if ok {
+		scope.TeamID = t.ID
+	}
+	settings, err := s.settings.Get(ctx, scope)
+	if err != nil {
+		return 0, err
+	}
+	user, _, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	filter := settings.Filter()
+	if !settings.LanguageFallback {
+		filter.Language = settings.QuestionLanguage(user.PreferredLanguage())
+	}
+	if scope.IsTeam() {
+		return s.questions.CountUnseenByTeam(ctx, scope.TeamID, userID, filter, Threshold)
+	}
+	return s.questions.CountUnseenByUser(ctx, userID, filter, Threshold)
+}
+
+func newQuestionsMessage(userID int64) schema.OutgoingMessage {
+	return schema.OutgoingMessage{
+		ChatID: userID,
+		Parts:  []schema.LocalizedText{{Key: "sub.new_questions"}},
+		Buttons: [][]schema.OutgoingButton{
+			{{Label: schema.LocalizedText{Key: "sub.play_button"}, CallbackData: "play"}},
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package telegram
+
+import (
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"fmt"
+	"time"
+)
+
+type Sender interface {
+	Send(ctx context.Context, msg schema.OutgoingMessage) error
+}
+
+type RetryAfterError struct {
+	After time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return fmt.Sprintf("retry after %s", e.After)
+}
@@ -0,0 +1,75 @@
+package trash
+
+import (
+	"LoudQuestionBot/internal/domain/repository"
+	"LoudQuestionBot/internal/domain/schema"
+	"context"
+	"log"
+	"time"
+)
+
+const purgeInterval = time.Hour
+
+type QuestionsListener interface {
+	QuestionsAdded()
+}
+
+type Service struct {
+	questions repository.QuestionRepository
+	listener  QuestionsListener
+	retention time.Duration
+}
+
+func New(questions repository.QuestionRepository, listener QuestionsListener, retention time.Duration) *Service {
+	return &Service{questions: questions, listener: listener, retention: retention}
+}
+
+func (s *Service) Retention() time.Duration {
+	return s.retention
+}
+
+func (s *Service) PurgeAt(q schema.DeletedQuestion) (time.Time, bool) {
+	if s.retention <= 0 {
+		return time.Time{}, false
+	}
+	return q.DeletedAt.Add(s.retention), true
+}
+
+func (s *Service) List(ctx context.Context, userID int64, page, pageSize int) (repository.ListDeletedResult, error) {
+	return s.questions.ListDeletedBy(ctx, userID, page, pageSize)
+}
+
+func (s *Service) Get(ctx context.Context, userID int64, questionID string) (schema.DeletedQuestion, error) {
+	return s.questions.GetDeletedBy(ctx, userID, questionID)
+}
+
+func (s *Service) Restore(ctx context.Context, userID int64, questionID string) error {
+	if err := s.questions.RestoreDeletedBy(ctx, userID, questionID); err != nil {
+		return err
+	}
+	s.listener.QuestionsAdded()
+	return nil
+}
+
+func (s *Service) Purge(ctx context.Context, userID int64, questionID string) error {
+	return s.questions.PurgeDeletedBy(ctx, userID, questionID)
+}
+
+func (s *Service) Start(ctx context.Context) {
+	if s.retention <= 0 {
+		return
+	}
+	for {
+		purged, err := s.questions.PurgeDeletedBefore(ctx, time.Now().Add(-s.retention))
+		if err != nil && ctx.Err() == nil {
+			log.Printf("purge deleted questions: %v", err)
+		} else if purged > 0 {
+			log.Printf("purged %d deleted questions", purged)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(purgeInterval):
+		}
+	}
+}
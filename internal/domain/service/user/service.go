@@ -1,6 +1,7 @@
 package user
 
 import (
+	"LoudQuestionBot/internal/domain/errorz"
 	"LoudQuestionBot/internal/domain/repository"
 	"LoudQuestionBot/internal/domain/schema"
 	"context"
@@ -25,3 +26,14 @@ func (s *Service) GetByID(ctx context.Context, userID int64) (schema.BotUser, bo
 func (s *Service) TouchInteraction(ctx context.Context, userID int64) error {
 	return s.repo.TouchInteraction(ctx, userID)
 }
+
+func (s *Service) SetDailyQuestion(ctx context.Context, userID int64, enabled bool) error {
+	return s.repo.SetDailyQuestion(ctx, userID, enabled)
+}
+
+func (s *Service) SetLanguage(ctx context.Context, userID int64, lang schema.Language) error {
+	if !lang.Valid() {
+		return errorz.ErrInvalid
+	}
+	return s.repo.SetLanguage(ctx, userID, lang)
+}